@@ -16,8 +16,10 @@ var (
 )
 
 type BudidayaConfig struct {
-	ImageConfig config.ImageConfig
-	DbConfig    config.DbConfig
+	ImageConfig    config.ImageConfig
+	DbConfig       config.DbConfig
+	FireBaseConfig config.FirebaseConfig
+	PaymentConfig  config.PaymentConfig
 }
 
 // single tone
@@ -40,6 +42,11 @@ func getConfig() *BudidayaConfig {
 
 			path := os.Getenv("PATH_IMAGE_BUDIDAYA")
 			url := os.Getenv("URL_IMAGE_BUDIDAYA")
+			firebaseConf := os.Getenv("FIREBASE_CONF")
+
+			paymentProvider := os.Getenv("PAYMENT_PROVIDER")
+			paymentSecretKey := os.Getenv("PAYMENT_SECRET_KEY")
+			paymentUrl := os.Getenv("PAYMENT_URL")
 
 			conf = &BudidayaConfig{
 				ImageConfig: config.ImageConfig{
@@ -47,6 +54,14 @@ func getConfig() *BudidayaConfig {
 					Path: path,
 				},
 				DbConfig: config.DbConfig{Driver: driver, Host: host, User: username, Password: password, Database: database, Port: port},
+				FireBaseConfig: config.FirebaseConfig{
+					FireBase: firebaseConf,
+				},
+				PaymentConfig: config.PaymentConfig{
+					Provider:   paymentProvider,
+					SecretKey:  paymentSecretKey,
+					PaymentUrl: paymentUrl,
+				},
 			}
 		})
 	}
@@ -78,6 +93,9 @@ func GetConfig() *BudidayaConfig {
 	if dbConf.Port == "" {
 		errs.Add(config.ErrEmptyConfig.AttacthDetail(map[string]any{"field empty Port": "empty"}))
 	}
+	if conf.PaymentConfig.Provider == "" {
+		errs.Add(config.ErrEmptyConfig.AttacthDetail(map[string]any{"field empty Payment Provider": "empty"}))
+	}
 
 	if err := errs.Return(); err != nil {
 		logger.Fatal("auth-config err: %v", err)
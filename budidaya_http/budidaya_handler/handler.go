@@ -1,10 +1,15 @@
 package budidayahandler
 
 import (
+	"strconv"
+	"time"
+
 	budidayaconfig "github.com/e-fish/api/budidaya_http/budidaya_config"
 	budidayaservice "github.com/e-fish/api/budidaya_http/budidaya_service"
+	"github.com/e-fish/api/pkg/common/helper/geo"
 	"github.com/e-fish/api/pkg/common/helper/restsvr"
 	"github.com/e-fish/api/pkg/common/helper/werror"
+	errorbudidaya "github.com/e-fish/api/pkg/domain/budidaya/error-budidaya"
 	"github.com/e-fish/api/pkg/domain/budidaya/model"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -125,7 +130,13 @@ func (h *Handler) ReadBudidayaNeaerest(c *gin.Context) {
 
 	defer restsvr.ResponsJson(c, res)
 
-	result, err := h.Service.ReadBudidayaNeaerest(ctx)
+	nearby, err := geo.ParseNearby(c.Query("lat"), c.Query("lng"), c.Query("radiusKm"))
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.ReadBudidayaNeaerest(ctx, nearby)
 	res.Add(result, err)
 }
 
@@ -152,3 +163,351 @@ func (h *Handler) GetAllFishSpecies(c *gin.Context) {
 	result, err := h.Service.GetAllFishSpecies(ctx)
 	res.Add(result, err)
 }
+
+func (h *Handler) UpdateStatusBudidaya(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req model.UpdateBudidayaStatusInput
+		res = new(restsvr.HttpResponse)
+	)
+
+	defer restsvr.ResponsJson(c, res)
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.UpdateStatusBudidaya(ctx, req)
+	res.Add(result, err)
+}
+
+func (h *Handler) CreateFeedingLog(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req model.CreateFeedingLogInput
+		res = new(restsvr.HttpResponse)
+	)
+
+	defer restsvr.ResponsJson(c, res)
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.CreateFeedingLog(ctx, req)
+	res.Add(result, err)
+}
+
+func (h *Handler) CreateMortalityLog(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req model.CreateMortalityLogInput
+		res = new(restsvr.HttpResponse)
+	)
+
+	defer restsvr.ResponsJson(c, res)
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.CreateMortalityLog(ctx, req)
+	res.Add(result, err)
+}
+
+func (h *Handler) CreateSamplingLog(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req model.CreateSamplingLogInput
+		res = new(restsvr.HttpResponse)
+	)
+
+	defer restsvr.ResponsJson(c, res)
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.CreateSamplingLog(ctx, req)
+	res.Add(result, err)
+}
+
+func (h *Handler) GetJournalBudidaya(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		res = new(restsvr.HttpResponse)
+	)
+
+	defer restsvr.ResponsJson(c, res)
+
+	uid, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.ReadJournalByBudidayaID(ctx, uid)
+	res.Add(result, err)
+}
+
+func (h *Handler) CreateMultipleWaterQuality(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req model.CreateMultipleWaterQualityInput
+		res = new(restsvr.HttpResponse)
+	)
+
+	defer restsvr.ResponsJson(c, res)
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.CreateMultipleWaterQuality(ctx, req)
+	res.Add(result, err)
+}
+
+func (h *Handler) UpdateWaterQualityRange(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req model.UpdateWaterQualityRangeInput
+		res = new(restsvr.HttpResponse)
+	)
+
+	defer restsvr.ResponsJson(c, res)
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.UpdateWaterQualityRange(ctx, req)
+	res.Add(result, err)
+}
+
+// GetWaterQualityPool read the readings of the pool, the date range use the format yyyy-mm-dd
+func (h *Handler) GetWaterQualityPool(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		res = new(restsvr.HttpResponse)
+	)
+
+	defer restsvr.ResponsJson(c, res)
+
+	uid, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	input := model.ReadWaterQualityInput{
+		PoolID: uid,
+	}
+
+	if startDate := c.Query("startDate"); startDate != "" {
+		input.StartDate, err = time.Parse("2006-01-02", startDate)
+		if err != nil {
+			res.Add(nil, errorbudidaya.ErrValidateInputWaterQuality.AttacthDetail(map[string]any{"startDate": startDate}))
+			return
+		}
+	}
+	if endDate := c.Query("endDate"); endDate != "" {
+		input.EndDate, err = time.Parse("2006-01-02", endDate)
+		if err != nil {
+			res.Add(nil, errorbudidaya.ErrValidateInputWaterQuality.AttacthDetail(map[string]any{"endDate": endDate}))
+			return
+		}
+		input.EndDate = input.EndDate.AddDate(0, 0, 1)
+	}
+
+	result, err := h.Service.ReadWaterQualityByPoolID(ctx, input)
+	res.Add(result, err)
+}
+
+func (h *Handler) CreateHarvest(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req model.CreateHarvestInput
+		res = new(restsvr.HttpResponse)
+	)
+
+	defer restsvr.ResponsJson(c, res)
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.Harvest(ctx, req)
+	res.Add(result, err)
+}
+
+func (h *Handler) GetHarvestBudidaya(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		res = new(restsvr.HttpResponse)
+	)
+
+	defer restsvr.ResponsJson(c, res)
+
+	uid, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.ReadHarvestByBudidayaID(ctx, uid)
+	res.Add(result, err)
+}
+
+func (h *Handler) UpdateGrowthCurve(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req model.UpdateGrowthCurveInput
+		res = new(restsvr.HttpResponse)
+	)
+
+	defer restsvr.ResponsJson(c, res)
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.UpdateGrowthCurve(ctx, req)
+	res.Add(result, err)
+}
+
+func (h *Handler) GetHarvestPrediction(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		res = new(restsvr.HttpResponse)
+	)
+
+	defer restsvr.ResponsJson(c, res)
+
+	uid, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.ReadHarvestPrediction(ctx, uid)
+	res.Add(result, err)
+}
+
+func (h *Handler) GetPricelistHistoryBudidaya(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		res = new(restsvr.HttpResponse)
+	)
+
+	defer restsvr.ResponsJson(c, res)
+
+	uid, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.ReadPricelistHistoryByBudidayaID(ctx, uid)
+	res.Add(result, err)
+}
+
+func (h *Handler) GetQuoteBudidaya(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		res = new(restsvr.HttpResponse)
+	)
+
+	defer restsvr.ResponsJson(c, res)
+
+	uid, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	qty, err := strconv.Atoi(c.Query("qty"))
+	if err != nil {
+		res.Add(nil, errorbudidaya.ErrValidateInputQuote.AttacthDetail(map[string]any{"qty": c.Query("qty")}))
+		return
+	}
+
+	result, err := h.Service.ReadQuote(ctx, model.ReadPricelistBudidayaInput{
+		BudidayaID: uid,
+		Qty:        qty,
+	})
+	res.Add(result, err)
+}
+
+// marketplaceInput read the filter, sort and page of the marketplace from the query param
+func marketplaceInput(c *gin.Context) (model.MarketplaceInput, error) {
+	input := model.MarketplaceInput{}
+
+	input.Limit, _ = strconv.Atoi(c.Query("limit"))
+	input.Page, _ = strconv.Atoi(c.Query("page"))
+	input.Sort = c.Query("sort")
+	input.Direction = c.Query("direction")
+
+	input.FishSpeciesID, _ = uuid.Parse(c.Query("fishSpeciesID"))
+	input.ProvinceID, _ = uuid.Parse(c.Query("provinceID"))
+	input.CityID, _ = uuid.Parse(c.Query("cityID"))
+	input.DistrictID, _ = uuid.Parse(c.Query("districtID"))
+	input.MinPrice, _ = strconv.Atoi(c.Query("minPrice"))
+	input.MaxPrice, _ = strconv.Atoi(c.Query("maxPrice"))
+	input.MinStock, _ = strconv.Atoi(c.Query("minStock"))
+
+	for key, dst := range map[string]**time.Time{"harvestFrom": &input.HarvestFrom, "harvestTo": &input.HarvestTo} {
+		if value := c.Query(key); value != "" {
+			date, err := time.Parse(model.DATE_FORMAT, value)
+			if err != nil {
+				return input, errorbudidaya.ErrValidateMarketplaceInput.AttacthDetail(map[string]any{key: value})
+			}
+			*dst = &date
+		}
+	}
+
+	for key, dst := range map[string]**float64{"lat": &input.Latitude, "lng": &input.Longitude} {
+		if value := c.Query(key); value != "" {
+			coordinate, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return input, errorbudidaya.ErrValidateMarketplaceInput.AttacthDetail(map[string]any{key: value})
+			}
+			*dst = &coordinate
+		}
+	}
+
+	return input, nil
+}
+
+func (h *Handler) GetMarketplace(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		res = new(restsvr.HttpResponse)
+	)
+
+	defer restsvr.ResponsJson(c, res)
+
+	input, err := marketplaceInput(c)
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.ReadMarketplace(ctx, input)
+	res.Add(result, err)
+}
@@ -1,6 +1,16 @@
 package budidayaservice
 
+import (
+	transactionModel "github.com/e-fish/api/pkg/domain/transaction/model"
+	"github.com/google/uuid"
+)
+
 type UploadPhotoResponse struct {
 	Name string
 	Url  string
 }
+
+type HarvestResponse struct {
+	ID    uuid.UUID                              `json:"id"`
+	Order *transactionModel.ReconcileOrderOutput `json:"order"`
+}
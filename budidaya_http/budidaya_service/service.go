@@ -2,24 +2,40 @@ package budidayaservice
 
 import (
 	"context"
+	"strings"
 
 	budidayaconfig "github.com/e-fish/api/budidaya_http/budidaya_config"
+	"github.com/e-fish/api/pkg/common/helper/geo"
 	"github.com/e-fish/api/pkg/common/helper/logger"
+	"github.com/e-fish/api/pkg/common/infra/firebase"
+	"github.com/e-fish/api/pkg/common/infra/payment"
 	"github.com/e-fish/api/pkg/domain/budidaya"
 	"github.com/e-fish/api/pkg/domain/budidaya/model"
+	"github.com/e-fish/api/pkg/domain/notification"
 	"github.com/e-fish/api/pkg/domain/pond"
+	"github.com/e-fish/api/pkg/domain/transaction"
 	"github.com/e-fish/api/pkg/domain/verification"
 	"github.com/google/uuid"
 )
 
 func NewService(conf budidayaconfig.BudidayaConfig) Service {
+	var (
+		ctx = context.Background()
+	)
 
 	verificationRepo, err := verification.NewRepo(conf.DbConfig)
 	if err != nil {
 		logger.Fatal("###failed create budidaya service err: %v", err)
 	}
 
-	pondRepo, err := pond.NewRepo(conf.DbConfig, verificationRepo)
+	messaging := newMessaging(ctx, conf)
+
+	notificationRepo, err := notification.NewRepo(conf.DbConfig, messaging)
+	if err != nil {
+		logger.Fatal("###failed create budidaya service err: %v", err)
+	}
+
+	pondRepo, err := pond.NewRepo(conf.DbConfig, verificationRepo, notificationRepo)
 	if err != nil {
 		logger.Fatal("###failed create budidaya service err: %v", err)
 	}
@@ -29,17 +45,49 @@ func NewService(conf budidayaconfig.BudidayaConfig) Service {
 		logger.Fatal("###failed create budidaya service err: %v", err)
 	}
 
+	paymentGateway, err := payment.NewPaymentGateway(conf.PaymentConfig)
+	if err != nil {
+		logger.Fatal("###failed create budidaya service err: %v", err)
+	}
+
+	transactionRepo, err := transaction.NewRepo(conf.DbConfig, buidayaRepo, pondRepo, paymentGateway)
+	if err != nil {
+		logger.Fatal("###failed create budidaya service err: %v", err)
+	}
+
 	service := Service{
-		conf: conf,
-		repo: buidayaRepo,
+		conf:            conf,
+		repo:            buidayaRepo,
+		transactionRepo: transactionRepo,
+		messaging:       messaging,
 	}
 
 	return service
 }
 
+// newMessaging create the push message, the push message is disabled when firebase is not configured
+// and the alert is only read in the app
+func newMessaging(ctx context.Context, conf budidayaconfig.BudidayaConfig) firebase.Messaging {
+	fb, err := firebase.NewFirebase(conf.FireBaseConfig)
+	if err != nil {
+		logger.Warn("###firebase is not configured, push message is disabled err: %v", err)
+		return nil
+	}
+
+	messaging, err := fb.NewMessaging(ctx)
+	if err != nil {
+		logger.Warn("###failed create firebase messaging, push message is disabled err: %v", err)
+		return nil
+	}
+
+	return messaging
+}
+
 type Service struct {
-	conf budidayaconfig.BudidayaConfig
-	repo budidaya.Repo
+	conf            budidayaconfig.BudidayaConfig
+	repo            budidaya.Repo
+	transactionRepo transaction.Repo
+	messaging       firebase.Messaging
 }
 
 func (s *Service) CreateBudidaya(ctx context.Context, input model.CreateBudidayaInput) (*uuid.UUID, error) {
@@ -82,6 +130,73 @@ func (s *Service) UpdateBudidayaWithPricelist(ctx context.Context, input model.U
 	return result, nil
 }
 
+func (s *Service) UpdateStatusBudidaya(ctx context.Context, input model.UpdateBudidayaStatusInput) (*uuid.UUID, error) {
+	if input.Status == model.FAILED {
+		return s.failBudidaya(ctx, input)
+	}
+
+	command := s.repo.NewCommand(ctx)
+
+	result, err := command.UpdateStatusBudidaya(ctx, input)
+	if err != nil {
+		if err := command.Rollback(ctx); err != nil {
+			logger.ErrorWithContext(ctx, "failed rollback transaction update status budidaya err: %v", err)
+		}
+		logger.ErrorWithContext(ctx, "failed update status budidaya err: %v", err)
+		return nil, err
+	}
+
+	if err := command.Commit(ctx); err != nil {
+		logger.ErrorWithContext(ctx, "failed commit transaction update status budidaya err: %v", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// failBudidaya reconcile the order of the budidaya in the same transaction, the unpaid order is canceled and the paid order is refunded.
+// the transaction command is created first, so its commit is the last and the refund is sent after the transaction is committed
+func (s *Service) failBudidaya(ctx context.Context, input model.UpdateBudidayaStatusInput) (*uuid.UUID, error) {
+	var (
+		transactionCommand = s.transactionRepo.NewCommand(ctx)
+		command            = s.repo.NewCommand(ctx)
+		rollback           = func() {
+			if err := command.Rollback(ctx); err != nil {
+				logger.ErrorWithContext(ctx, "failed rollback transaction update status budidaya err: %v", err)
+			}
+			if err := transactionCommand.Rollback(ctx); err != nil {
+				logger.ErrorWithContext(ctx, "failed rollback transaction reconcile order failed err: %v", err)
+			}
+		}
+	)
+
+	result, err := command.UpdateStatusBudidaya(ctx, input)
+	if err != nil {
+		rollback()
+		logger.ErrorWithContext(ctx, "failed update status budidaya err: %v", err)
+		return nil, err
+	}
+
+	_, err = transactionCommand.ReconcileOrderFailed(ctx, input.ID)
+	if err != nil {
+		rollback()
+		logger.ErrorWithContext(ctx, "failed reconcile order failed err: %v", err)
+		return nil, err
+	}
+
+	if err := command.Commit(ctx); err != nil {
+		logger.ErrorWithContext(ctx, "failed commit transaction update status budidaya err: %v", err)
+		return nil, err
+	}
+
+	if err := transactionCommand.Commit(ctx); err != nil {
+		logger.ErrorWithContext(ctx, "failed commit transaction reconcile order failed err: %v", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
 func (s *Service) CreateFishSpecies(ctx context.Context, input model.CreateFishSpeciesInput) (*uuid.UUID, error) {
 	command := s.repo.NewCommand(ctx)
 
@@ -137,7 +252,243 @@ func (s *Service) GetAllFishSpecies(ctx context.Context) ([]*model.FishSpeciesOu
 	return query.ReadAllDataFishSpecies(ctx)
 }
 
-func (s *Service) ReadBudidayaNeaerest(ctx context.Context) ([]*model.BudidayaOutput, error) {
+func (s *Service) ReadBudidayaNeaerest(ctx context.Context, nearby geo.Nearby) ([]*model.BudidayaOutput, error) {
+	query := s.repo.NewQuery()
+	return query.ReadBudidayaNeaerest(ctx, nearby)
+}
+
+func (s *Service) CreateFeedingLog(ctx context.Context, input model.CreateFeedingLogInput) (*uuid.UUID, error) {
+	command := s.repo.NewCommand(ctx)
+
+	result, err := command.CreateFeedingLog(ctx, input)
+	if err != nil {
+		if err := command.Rollback(ctx); err != nil {
+			logger.ErrorWithContext(ctx, "failed rollback transaction create feeding log err: %v", err)
+		}
+		logger.ErrorWithContext(ctx, "failed create feeding log err: %v", err)
+		return nil, err
+	}
+
+	if err := command.Commit(ctx); err != nil {
+		logger.ErrorWithContext(ctx, "failed commit transaction create feeding log err: %v", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *Service) CreateMortalityLog(ctx context.Context, input model.CreateMortalityLogInput) (*uuid.UUID, error) {
+	command := s.repo.NewCommand(ctx)
+
+	result, err := command.CreateMortalityLog(ctx, input)
+	if err != nil {
+		if err := command.Rollback(ctx); err != nil {
+			logger.ErrorWithContext(ctx, "failed rollback transaction create mortality log err: %v", err)
+		}
+		logger.ErrorWithContext(ctx, "failed create mortality log err: %v", err)
+		return nil, err
+	}
+
+	if err := command.Commit(ctx); err != nil {
+		logger.ErrorWithContext(ctx, "failed commit transaction create mortality log err: %v", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *Service) CreateSamplingLog(ctx context.Context, input model.CreateSamplingLogInput) (*uuid.UUID, error) {
+	command := s.repo.NewCommand(ctx)
+
+	result, err := command.CreateSamplingLog(ctx, input)
+	if err != nil {
+		if err := command.Rollback(ctx); err != nil {
+			logger.ErrorWithContext(ctx, "failed rollback transaction create sampling log err: %v", err)
+		}
+		logger.ErrorWithContext(ctx, "failed create sampling log err: %v", err)
+		return nil, err
+	}
+
+	if err := command.Commit(ctx); err != nil {
+		logger.ErrorWithContext(ctx, "failed commit transaction create sampling log err: %v", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *Service) ReadJournalByBudidayaID(ctx context.Context, budidayaID uuid.UUID) (*model.JournalOutput, error) {
+	query := s.repo.NewQuery()
+	return query.ReadJournalByBudidayaID(ctx, budidayaID)
+}
+
+// CreateMultipleWaterQuality save the readings and push the alert to the pond owner after the readings is committed
+func (s *Service) CreateMultipleWaterQuality(ctx context.Context, input model.CreateMultipleWaterQualityInput) (*model.CreateWaterQualityOutput, error) {
+	command := s.repo.NewCommand(ctx)
+
+	result, err := command.CreateMultipleWaterQuality(ctx, input)
+	if err != nil {
+		if err := command.Rollback(ctx); err != nil {
+			logger.ErrorWithContext(ctx, "failed rollback transaction create water quality err: %v", err)
+		}
+		logger.ErrorWithContext(ctx, "failed create water quality err: %v", err)
+		return nil, err
+	}
+
+	if err := command.Commit(ctx); err != nil {
+		logger.ErrorWithContext(ctx, "failed commit transaction create water quality err: %v", err)
+		return nil, err
+	}
+
+	s.sendWaterQualityAlert(ctx, result)
+
+	return result, nil
+}
+
+// sendWaterQualityAlert push one notification per pool, the topic is the user id of the pond owner
+func (s *Service) sendWaterQualityAlert(ctx context.Context, result *model.CreateWaterQualityOutput) {
+	if s.messaging == nil {
+		return
+	}
+
+	var (
+		messages = map[uuid.UUID][]string{}
+		pools    = []uuid.UUID{}
+	)
+
+	for _, v := range result.Alerts {
+		if _, ok := messages[v.PoolID]; !ok {
+			pools = append(pools, v.PoolID)
+		}
+		messages[v.PoolID] = append(messages[v.PoolID], v.Message())
+	}
+
+	for _, poolID := range pools {
+		s.messaging.SendMessage(ctx, firebase.FirebaseMessageData{
+			Title: "Water quality alert",
+			Body:  strings.Join(messages[poolID], "\n"),
+			Tag:   "water-quality-alert",
+			Data: map[string]string{
+				"type":   "water-quality-alert",
+				"poolID": poolID.String(),
+			},
+			Topic: result.UserID.String(),
+		})
+	}
+}
+
+func (s *Service) UpdateWaterQualityRange(ctx context.Context, input model.UpdateWaterQualityRangeInput) (*uuid.UUID, error) {
+	command := s.repo.NewCommand(ctx)
+
+	result, err := command.UpdateWaterQualityRange(ctx, input)
+	if err != nil {
+		if err := command.Rollback(ctx); err != nil {
+			logger.ErrorWithContext(ctx, "failed rollback transaction update water quality range err: %v", err)
+		}
+		logger.ErrorWithContext(ctx, "failed update water quality range err: %v", err)
+		return nil, err
+	}
+
+	if err := command.Commit(ctx); err != nil {
+		logger.ErrorWithContext(ctx, "failed commit transaction update water quality range err: %v", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *Service) ReadWaterQualityByPoolID(ctx context.Context, input model.ReadWaterQualityInput) ([]*model.WaterQualityOutput, error) {
+	query := s.repo.NewQuery()
+	return query.ReadWaterQualityByPoolID(ctx, input)
+}
+
+// Harvest reconcile the order of the budidaya before the budidaya is closed,
+// both command share the transaction of the ctx so the harvest is rolled back when one of them is failed
+func (s *Service) Harvest(ctx context.Context, input model.CreateHarvestInput) (*HarvestResponse, error) {
+	var (
+		command            = s.repo.NewCommand(ctx)
+		transactionCommand = s.transactionRepo.NewCommand(ctx)
+		rollback           = func() {
+			if err := transactionCommand.Rollback(ctx); err != nil {
+				logger.ErrorWithContext(ctx, "failed rollback transaction reconcile order harvest err: %v", err)
+			}
+			if err := command.Rollback(ctx); err != nil {
+				logger.ErrorWithContext(ctx, "failed rollback transaction create harvest err: %v", err)
+			}
+		}
+	)
+
+	orders, err := transactionCommand.ReconcileOrderHarvest(ctx, input.BudidayaID)
+	if err != nil {
+		rollback()
+		logger.ErrorWithContext(ctx, "failed reconcile order harvest err: %v", err)
+		return nil, err
+	}
+
+	result, err := command.CreateHarvest(ctx, input)
+	if err != nil {
+		rollback()
+		logger.ErrorWithContext(ctx, "failed create harvest err: %v", err)
+		return nil, err
+	}
+
+	if err := transactionCommand.Commit(ctx); err != nil {
+		logger.ErrorWithContext(ctx, "failed commit transaction reconcile order harvest err: %v", err)
+		return nil, err
+	}
+
+	if err := command.Commit(ctx); err != nil {
+		logger.ErrorWithContext(ctx, "failed commit transaction create harvest err: %v", err)
+		return nil, err
+	}
+
+	return &HarvestResponse{
+		ID:    *result,
+		Order: orders,
+	}, nil
+}
+
+func (s *Service) ReadHarvestByBudidayaID(ctx context.Context, budidayaID uuid.UUID) (*model.HarvestOutput, error) {
+	query := s.repo.NewQuery()
+	return query.ReadHarvestByBudidayaID(ctx, budidayaID)
+}
+
+func (s *Service) UpdateGrowthCurve(ctx context.Context, input model.UpdateGrowthCurveInput) (*uuid.UUID, error) {
+	command := s.repo.NewCommand(ctx)
+
+	result, err := command.UpdateGrowthCurve(ctx, input)
+	if err != nil {
+		if err := command.Rollback(ctx); err != nil {
+			logger.ErrorWithContext(ctx, "failed rollback transaction update growth curve err: %v", err)
+		}
+		logger.ErrorWithContext(ctx, "failed update growth curve err: %v", err)
+		return nil, err
+	}
+
+	if err := command.Commit(ctx); err != nil {
+		logger.ErrorWithContext(ctx, "failed commit transaction update growth curve err: %v", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *Service) ReadHarvestPrediction(ctx context.Context, budidayaID uuid.UUID) (*model.HarvestPredictionOutput, error) {
+	query := s.repo.NewQuery()
+	return query.ReadHarvestPrediction(ctx, budidayaID)
+}
+
+func (s *Service) ReadPricelistHistoryByBudidayaID(ctx context.Context, budidayaID uuid.UUID) ([]*model.PriceListOutput, error) {
+	query := s.repo.NewQuery()
+	return query.ReadPricelistHistoryByBudidayaID(ctx, budidayaID)
+}
+
+func (s *Service) ReadQuote(ctx context.Context, input model.ReadPricelistBudidayaInput) (*model.QuoteOutput, error) {
+	query := s.repo.NewQuery()
+	return query.ReadQuote(ctx, input)
+}
+
+func (s *Service) ReadMarketplace(ctx context.Context, input model.MarketplaceInput) (*model.MarketplaceOutput, error) {
 	query := s.repo.NewQuery()
-	return query.ReadBudidayaNeaerest(ctx)
+	return query.ReadMarketplace(ctx, input)
 }
@@ -5,6 +5,8 @@ import (
 	budidayahandler "github.com/e-fish/api/budidaya_http/budidaya_handler"
 	budidayaservice "github.com/e-fish/api/budidaya_http/budidaya_service"
 	"github.com/e-fish/api/pkg/common/helper/ctxutil"
+	"github.com/e-fish/api/pkg/common/helper/logger"
+	"github.com/e-fish/api/pkg/common/helper/restsvr"
 	"github.com/gin-gonic/gin"
 )
 
@@ -22,14 +24,39 @@ func newRoute(ro route) {
 		Service: service,
 	}
 
-	ginEngine.POST("/create-budidaya", ctxutil.Authorization(), handler.CreateBudidaya)
+	idempotencyStore, err := restsvr.NewIdempotencyStore(ro.conf.DbConfig)
+	if err != nil {
+		logger.Fatal("###failed create idempotency store err: %v", err)
+	}
+	idempotency := restsvr.Idempotency(idempotencyStore)
+
+	ginEngine.POST("/create-budidaya", ctxutil.Authorization(), idempotency, handler.CreateBudidaya)
 	ginEngine.POST("/create-fish-species", ctxutil.Authorization(), handler.CreateFishSpecies)
-	ginEngine.POST("/create-multiple-pricelist", ctxutil.Authorization(), handler.CreateMultiplePricelist)
+	ginEngine.POST("/create-multiple-pricelist", ctxutil.Authorization(), idempotency, handler.CreateMultiplePricelist)
 
 	ginEngine.POST("/update-budidaya-with-pricelist", ctxutil.Authorization(), handler.UpdateBudidayaWithPricelist)
+	ginEngine.POST("/update-status-budidaya", ctxutil.Authorization(), handler.UpdateStatusBudidaya)
+	ginEngine.GET("/budidaya/:id/pricelist-history", ctxutil.Authorization(), handler.GetPricelistHistoryBudidaya)
+
+	ginEngine.POST("/create-feeding-log", ctxutil.Authorization(), handler.CreateFeedingLog)
+	ginEngine.POST("/create-mortality-log", ctxutil.Authorization(), handler.CreateMortalityLog)
+	ginEngine.POST("/create-sampling-log", ctxutil.Authorization(), handler.CreateSamplingLog)
+	ginEngine.GET("/budidaya/:id/journal", ctxutil.Authorization(), handler.GetJournalBudidaya)
+
+	ginEngine.POST("/create-multiple-water-quality", ctxutil.Authorization(), handler.CreateMultipleWaterQuality)
+	ginEngine.POST("/update-water-quality-range", ctxutil.Authorization(), handler.UpdateWaterQualityRange)
+	ginEngine.GET("/pool/:id/water-quality", ctxutil.Authorization(), handler.GetWaterQualityPool)
+
+	ginEngine.POST("/create-harvest", ctxutil.Authorization(), idempotency, handler.CreateHarvest)
+	ginEngine.GET("/budidaya/:id/harvest", ctxutil.Authorization(), handler.GetHarvestBudidaya)
+
+	ginEngine.POST("/update-growth-curve", ctxutil.Authorization(), handler.UpdateGrowthCurve)
+	ginEngine.GET("/budidaya/:id/harvest-prediction", ctxutil.Authorization(), handler.GetHarvestPrediction)
 
 	ginEngine.GET("/list-fish-species", handler.GetAllFishSpecies)
 	ginEngine.GET("/list-budidaya-seller", ctxutil.Authorization(), handler.GetBudidayaForSeller)
 	ginEngine.GET("/list-budidaya", ctxutil.Authorization(), handler.GetBudidayaAdminAndCustomer)
 	ginEngine.GET("/nearest-budidaya", handler.ReadBudidayaNeaerest)
+	ginEngine.GET("/budidaya/:id/quote", handler.GetQuoteBudidaya)
+	ginEngine.GET("/marketplace", handler.GetMarketplace)
 }
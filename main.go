@@ -5,6 +5,7 @@ import (
 	bannerhttp "github.com/e-fish/api/banner_http"
 	budidayahttp "github.com/e-fish/api/budidaya_http"
 	mainconfig "github.com/e-fish/api/main_config"
+	notificationhttp "github.com/e-fish/api/notification_http"
 	"github.com/e-fish/api/pkg/common/helper/logger"
 	"github.com/e-fish/api/pkg/common/helper/ptime"
 	"github.com/e-fish/api/pkg/common/helper/restsvr"
@@ -40,6 +41,8 @@ func main() {
 	regionhttp.NewRegionHttp()
 	//register banner http in main
 	bannerhttp.NewRegionHttp()
+	//register notification http in main
+	notificationhttp.NewNotificationHttp()
 
 	scheduler.Scheduler()
 
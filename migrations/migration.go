@@ -92,6 +92,23 @@ func Migrate(db *gorm.DB, flag string) error {
 			&City{},
 			&District{},
 			&Banner{},
+			&OrderStatusHistory{},
+			&Payment{},
+			&OrderGroup{},
+			&Cart{},
+			&CartItem{},
+			&IdempotencyKey{},
+			&FeedingLog{},
+			&MortalityLog{},
+			&SamplingLog{},
+			&WaterQuality{},
+			&WaterQualityRange{},
+			&WaterQualityAlert{},
+			&Harvest{},
+			&HarvestGrade{},
+			&ReviewNote{},
+			&Notification{},
+			&TeamMember{},
 		)
 		if err != nil {
 			logger.Info("Error Auto Migreate: %v", err)
@@ -539,6 +556,896 @@ func Migrate(db *gorm.DB, flag string) error {
 			},
 		}
 
+		updateOrderStatus := uuid.MustParse("644a5f6c-1c61-598a-87bf-c8658d626cd4")
+		updateOrderStatusPermission := model.Permission{
+			ID:   updateOrderStatus,
+			Code: "PM0022",
+			Name: "update order status",
+			Path: "/update-order-status",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("a7a1b399-775e-5f43-ab7d-41da6c6546d3"),
+					RoleID:         buyer,
+					PermissionName: "update order status",
+					PermissionPath: "/update-order-status",
+				},
+				{
+					ID:             uuid.MustParse("8b2393f7-99ec-5046-8b95-334d17286a0e"),
+					RoleID:         seller,
+					PermissionName: "update order status",
+					PermissionPath: "/update-order-status",
+				},
+				{
+					ID:             uuid.MustParse("40cd6b29-cbb1-5880-b080-71291022e4df"),
+					RoleID:         admin,
+					PermissionName: "update order status",
+					PermissionPath: "/update-order-status",
+				},
+			},
+		}
+
+		getOrderStatusHistory := uuid.MustParse("663891b0-9abe-58d2-a088-1763377288e8")
+		getOrderStatusHistoryPermission := model.Permission{
+			ID:   getOrderStatusHistory,
+			Code: "PM0023",
+			Name: "order status history",
+			Path: "/order/:id/history",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("7e3cab78-6373-5bc2-9830-15c6b6a77335"),
+					RoleID:         buyer,
+					PermissionName: "order status history",
+					PermissionPath: "/order/:id/history",
+				},
+				{
+					ID:             uuid.MustParse("234c230d-bdda-53ba-bf74-a0cb6748abd2"),
+					RoleID:         seller,
+					PermissionName: "order status history",
+					PermissionPath: "/order/:id/history",
+				},
+				{
+					ID:             uuid.MustParse("0b88edab-f8d7-5d65-86eb-d6902943d0eb"),
+					RoleID:         admin,
+					PermissionName: "order status history",
+					PermissionPath: "/order/:id/history",
+				},
+			},
+		}
+
+		createPayment := uuid.MustParse("93b76677-a7c4-560e-91d0-fc7c0a6cddd6")
+		createPaymentPermission := model.Permission{
+			ID:   createPayment,
+			Code: "PM0024",
+			Name: "create payment",
+			Path: "/create-payment",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("ae13b4c2-de51-5dec-84eb-2ad26dff4356"),
+					RoleID:         buyer,
+					PermissionName: "create payment",
+					PermissionPath: "/create-payment",
+				},
+			},
+		}
+
+		getOrderDetail := uuid.MustParse("4f704bca-43e2-586a-b1f2-5f26466b75d5")
+		getOrderDetailPermission := model.Permission{
+			ID:   getOrderDetail,
+			Code: "PM0025",
+			Name: "order detail",
+			Path: "/order/:id",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("8ad5f340-a292-5d26-930b-aabe24c1da7c"),
+					RoleID:         buyer,
+					PermissionName: "order detail",
+					PermissionPath: "/order/:id",
+				},
+				{
+					ID:             uuid.MustParse("d8ef999d-ca8f-5a9a-9d15-ab395d1de773"),
+					RoleID:         seller,
+					PermissionName: "order detail",
+					PermissionPath: "/order/:id",
+				},
+				{
+					ID:             uuid.MustParse("895d3aff-6792-5bf5-85c0-0c0754f7b58a"),
+					RoleID:         admin,
+					PermissionName: "order detail",
+					PermissionPath: "/order/:id",
+				},
+			},
+		}
+
+		updateOrder := uuid.MustParse("818299ed-999e-5dc3-979d-3d5a97594fa9")
+		updateOrderPermission := model.Permission{
+			ID:   updateOrder,
+			Code: "PM0026",
+			Name: "update order",
+			Path: "/update-order",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("7bd466b3-f3a5-5081-9911-8138af08d628"),
+					RoleID:         buyer,
+					PermissionName: "update order",
+					PermissionPath: "/update-order",
+				},
+			},
+		}
+
+		addCartItem := uuid.MustParse("7a7e644b-5424-5bc9-b710-30766d79315c")
+		addCartItemPermission := model.Permission{
+			ID:   addCartItem,
+			Code: "PM0027",
+			Name: "add cart item",
+			Path: "/add-cart-item",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("d65be87c-3854-5baf-847d-e796d24fabfa"),
+					RoleID:         buyer,
+					PermissionName: "add cart item",
+					PermissionPath: "/add-cart-item",
+				},
+			},
+		}
+
+		updateCartItem := uuid.MustParse("8af567f5-64f2-5c28-9426-b2c327b924fb")
+		updateCartItemPermission := model.Permission{
+			ID:   updateCartItem,
+			Code: "PM0028",
+			Name: "update cart item",
+			Path: "/update-cart-item",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("32db3b78-63ac-5634-b458-7f00fc1fc330"),
+					RoleID:         buyer,
+					PermissionName: "update cart item",
+					PermissionPath: "/update-cart-item",
+				},
+			},
+		}
+
+		checkout := uuid.MustParse("25e19d9e-4fc3-59a4-b41c-ac981e2781d7")
+		checkoutPermission := model.Permission{
+			ID:   checkout,
+			Code: "PM0029",
+			Name: "checkout",
+			Path: "/checkout",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("3a7e7e49-bb68-5724-91fb-06def988e2b7"),
+					RoleID:         buyer,
+					PermissionName: "checkout",
+					PermissionPath: "/checkout",
+				},
+			},
+		}
+
+		getCart := uuid.MustParse("96d70d9f-ae01-5320-8ef8-31909379cbfd")
+		getCartPermission := model.Permission{
+			ID:   getCart,
+			Code: "PM0030",
+			Name: "cart",
+			Path: "/cart",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("55757a14-b090-5de5-8f6e-1e0ff6678d35"),
+					RoleID:         buyer,
+					PermissionName: "cart",
+					PermissionPath: "/cart",
+				},
+			},
+		}
+
+		getOrderInvoice := uuid.MustParse("156f9122-8991-5e26-a8c4-eec99d66f195")
+		getOrderInvoicePermission := model.Permission{
+			ID:   getOrderInvoice,
+			Code: "PM0031",
+			Name: "order invoice",
+			Path: "/order/:id/invoice",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("295af6bd-87c4-50c6-abac-9ea0afb69f54"),
+					RoleID:         buyer,
+					PermissionName: "order invoice",
+					PermissionPath: "/order/:id/invoice",
+				},
+				{
+					ID:             uuid.MustParse("703c7a19-f4b4-54e5-8375-393215836341"),
+					RoleID:         seller,
+					PermissionName: "order invoice",
+					PermissionPath: "/order/:id/invoice",
+				},
+				{
+					ID:             uuid.MustParse("f883b641-2498-503b-9c0c-8f9360d57db3"),
+					RoleID:         admin,
+					PermissionName: "order invoice",
+					PermissionPath: "/order/:id/invoice",
+				},
+			},
+		}
+
+		getSalesRevenue := uuid.MustParse("25d65751-5841-506b-8c34-1132e6090d28")
+		getSalesRevenuePermission := model.Permission{
+			ID:   getSalesRevenue,
+			Code: "PM0032",
+			Name: "sales revenue",
+			Path: "/sales-revenue",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("5479aab9-0037-5d96-9f37-22f0830b2ac3"),
+					RoleID:         seller,
+					PermissionName: "sales revenue",
+					PermissionPath: "/sales-revenue",
+				},
+				{
+					ID:             uuid.MustParse("bf61acdc-45dc-5afc-9c43-d87a9d97fec7"),
+					RoleID:         admin,
+					PermissionName: "sales revenue",
+					PermissionPath: "/sales-revenue",
+				},
+			},
+		}
+
+		getSalesFishSpecies := uuid.MustParse("314e99f9-d4ba-55e2-b8fd-7c741e3ac5f1")
+		getSalesFishSpeciesPermission := model.Permission{
+			ID:   getSalesFishSpecies,
+			Code: "PM0033",
+			Name: "sales fish species",
+			Path: "/sales-fish-species",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("122be27b-924c-5b45-80d1-b668e957f13b"),
+					RoleID:         seller,
+					PermissionName: "sales fish species",
+					PermissionPath: "/sales-fish-species",
+				},
+				{
+					ID:             uuid.MustParse("334c36a1-012a-5d86-89ab-1ed59b4a3248"),
+					RoleID:         admin,
+					PermissionName: "sales fish species",
+					PermissionPath: "/sales-fish-species",
+				},
+			},
+		}
+
+		getSalesTopBuyer := uuid.MustParse("5dbc5f7d-0feb-5ca7-88a5-53e91aae086b")
+		getSalesTopBuyerPermission := model.Permission{
+			ID:   getSalesTopBuyer,
+			Code: "PM0034",
+			Name: "sales top buyer",
+			Path: "/sales-top-buyer",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("0c0417a5-d156-56ab-97e1-2ee3466b1c10"),
+					RoleID:         seller,
+					PermissionName: "sales top buyer",
+					PermissionPath: "/sales-top-buyer",
+				},
+				{
+					ID:             uuid.MustParse("65f7df90-887c-5a90-83f0-ea91bbd701f7"),
+					RoleID:         admin,
+					PermissionName: "sales top buyer",
+					PermissionPath: "/sales-top-buyer",
+				},
+			},
+		}
+
+		getSalesSummary := uuid.MustParse("3c998a82-639a-5d86-b01f-534dcb9c173e")
+		getSalesSummaryPermission := model.Permission{
+			ID:   getSalesSummary,
+			Code: "PM0035",
+			Name: "sales summary",
+			Path: "/sales-summary",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("42ef8764-6e1e-5d8f-b6c1-e6c1ffcf7605"),
+					RoleID:         seller,
+					PermissionName: "sales summary",
+					PermissionPath: "/sales-summary",
+				},
+				{
+					ID:             uuid.MustParse("01971227-c995-538c-bdca-a27a36f7a356"),
+					RoleID:         admin,
+					PermissionName: "sales summary",
+					PermissionPath: "/sales-summary",
+				},
+			},
+		}
+
+		createFeedingLog := uuid.MustParse("58e51bb0-48ba-5f52-b18d-4f4d32d9d4dd")
+		createFeedingLogPermission := model.Permission{
+			ID:   createFeedingLog,
+			Code: "PM0036",
+			Name: "create feeding log",
+			Path: "/create-feeding-log",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("b0798d5d-668b-50ce-acbd-8f4468004948"),
+					RoleID:         seller,
+					PermissionName: "create feeding log",
+					PermissionPath: "/create-feeding-log",
+				},
+			},
+		}
+
+		createMortalityLog := uuid.MustParse("70b3f6c1-2db1-5f52-949a-c4104a1696c0")
+		createMortalityLogPermission := model.Permission{
+			ID:   createMortalityLog,
+			Code: "PM0037",
+			Name: "create mortality log",
+			Path: "/create-mortality-log",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("8ac64286-6fa2-522e-9914-ed7953947bd4"),
+					RoleID:         seller,
+					PermissionName: "create mortality log",
+					PermissionPath: "/create-mortality-log",
+				},
+			},
+		}
+
+		createSamplingLog := uuid.MustParse("887b3ed5-853a-53e4-840d-ce26c7c7a8c6")
+		createSamplingLogPermission := model.Permission{
+			ID:   createSamplingLog,
+			Code: "PM0038",
+			Name: "create sampling log",
+			Path: "/create-sampling-log",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("dc8ad9db-92df-5b36-90e0-1372e62513e3"),
+					RoleID:         seller,
+					PermissionName: "create sampling log",
+					PermissionPath: "/create-sampling-log",
+				},
+			},
+		}
+
+		getJournalBudidaya := uuid.MustParse("13363e91-d139-50ec-8551-81d453b70827")
+		getJournalBudidayaPermission := model.Permission{
+			ID:   getJournalBudidaya,
+			Code: "PM0039",
+			Name: "journal budidaya",
+			Path: "/budidaya/:id/journal",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("dd000ff6-233a-5086-924f-cf0fcdaf5a0e"),
+					RoleID:         seller,
+					PermissionName: "journal budidaya",
+					PermissionPath: "/budidaya/:id/journal",
+				},
+				{
+					ID:             uuid.MustParse("4bd68090-e90b-5954-accb-c7462f63d831"),
+					RoleID:         admin,
+					PermissionName: "journal budidaya",
+					PermissionPath: "/budidaya/:id/journal",
+				},
+			},
+		}
+
+		createMultipleWaterQuality := uuid.MustParse("3e936f94-ad93-5baf-b408-0c7a0f580eac")
+		createMultipleWaterQualityPermission := model.Permission{
+			ID:   createMultipleWaterQuality,
+			Code: "PM0040",
+			Name: "create multiple water quality",
+			Path: "/create-multiple-water-quality",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("8b10ebbe-0b0b-507e-885b-ffcb23596909"),
+					RoleID:         seller,
+					PermissionName: "create multiple water quality",
+					PermissionPath: "/create-multiple-water-quality",
+				},
+			},
+		}
+
+		updateWaterQualityRange := uuid.MustParse("4266a3e8-8c10-5b06-876a-197db16a2524")
+		updateWaterQualityRangePermission := model.Permission{
+			ID:   updateWaterQualityRange,
+			Code: "PM0041",
+			Name: "update water quality range",
+			Path: "/update-water-quality-range",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("b05f9896-2553-5ebb-9d26-7dfadfbf475c"),
+					RoleID:         seller,
+					PermissionName: "update water quality range",
+					PermissionPath: "/update-water-quality-range",
+				},
+				{
+					ID:             uuid.MustParse("dd2673d4-86ef-5cda-85c1-fe344d270bec"),
+					RoleID:         admin,
+					PermissionName: "update water quality range",
+					PermissionPath: "/update-water-quality-range",
+				},
+			},
+		}
+
+		getWaterQualityPool := uuid.MustParse("814a6d2f-e389-5c62-8f07-0d3d5e1443d7")
+		getWaterQualityPoolPermission := model.Permission{
+			ID:   getWaterQualityPool,
+			Code: "PM0042",
+			Name: "water quality pool",
+			Path: "/pool/:id/water-quality",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("33051a86-f09d-548f-ab46-146742fb1623"),
+					RoleID:         seller,
+					PermissionName: "water quality pool",
+					PermissionPath: "/pool/:id/water-quality",
+				},
+				{
+					ID:             uuid.MustParse("a0a748df-eb23-5cee-a282-c98096b0ef37"),
+					RoleID:         admin,
+					PermissionName: "water quality pool",
+					PermissionPath: "/pool/:id/water-quality",
+				},
+			},
+		}
+
+		createHarvest := uuid.MustParse("4e90b443-bfdb-546e-996e-bbfbed5603ad")
+		createHarvestPermission := model.Permission{
+			ID:   createHarvest,
+			Code: "PM0043",
+			Name: "create harvest",
+			Path: "/create-harvest",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("825a43b0-c568-5b31-ada7-88245ecf1d44"),
+					RoleID:         seller,
+					PermissionName: "create harvest",
+					PermissionPath: "/create-harvest",
+				},
+				{
+					ID:             uuid.MustParse("0787669f-9ca0-562c-a7af-223891da38c8"),
+					RoleID:         admin,
+					PermissionName: "create harvest",
+					PermissionPath: "/create-harvest",
+				},
+			},
+		}
+
+		getHarvestBudidaya := uuid.MustParse("63040ee1-4eca-5475-82f7-8a1f5dc823dd")
+		getHarvestBudidayaPermission := model.Permission{
+			ID:   getHarvestBudidaya,
+			Code: "PM0044",
+			Name: "harvest budidaya",
+			Path: "/budidaya/:id/harvest",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("b5cacf56-0141-584d-a799-d3c3366b34c3"),
+					RoleID:         seller,
+					PermissionName: "harvest budidaya",
+					PermissionPath: "/budidaya/:id/harvest",
+				},
+				{
+					ID:             uuid.MustParse("ea13ff47-6c1a-51ce-9bbc-517b7056846c"),
+					RoleID:         admin,
+					PermissionName: "harvest budidaya",
+					PermissionPath: "/budidaya/:id/harvest",
+				},
+			},
+		}
+
+		updateStatusBudidaya := uuid.MustParse("e4ec763f-a5f8-517a-b50f-885d342023bc")
+		updateStatusBudidayaPermission := model.Permission{
+			ID:   updateStatusBudidaya,
+			Code: "PM0045",
+			Name: "update status budidaya",
+			Path: "/update-status-budidaya",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("a3f806d5-e950-5227-a795-0b9b71ca7c09"),
+					RoleID:         seller,
+					PermissionName: "update status budidaya",
+					PermissionPath: "/update-status-budidaya",
+				},
+				{
+					ID:             uuid.MustParse("bbf86039-1f9a-563e-854e-ad6f637070d7"),
+					RoleID:         admin,
+					PermissionName: "update status budidaya",
+					PermissionPath: "/update-status-budidaya",
+				},
+			},
+		}
+
+		updateGrowthCurve := uuid.MustParse("6118c8cb-3e82-54bb-87de-1ed13b4f1936")
+		updateGrowthCurvePermission := model.Permission{
+			ID:   updateGrowthCurve,
+			Code: "PM0046",
+			Name: "update growth curve",
+			Path: "/update-growth-curve",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("29c07601-6e3e-5470-97ec-a6f9ed5f68c9"),
+					RoleID:         seller,
+					PermissionName: "update growth curve",
+					PermissionPath: "/update-growth-curve",
+				},
+				{
+					ID:             uuid.MustParse("28a93801-ed9d-5ea8-88d9-852e73295d59"),
+					RoleID:         admin,
+					PermissionName: "update growth curve",
+					PermissionPath: "/update-growth-curve",
+				},
+			},
+		}
+
+		getHarvestPrediction := uuid.MustParse("91318661-167c-56d9-aae1-81c9681d5005")
+		getHarvestPredictionPermission := model.Permission{
+			ID:   getHarvestPrediction,
+			Code: "PM0047",
+			Name: "harvest prediction",
+			Path: "/budidaya/:id/harvest-prediction",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("d3da530e-360e-5f58-a6b6-d40a16b4822f"),
+					RoleID:         seller,
+					PermissionName: "harvest prediction",
+					PermissionPath: "/budidaya/:id/harvest-prediction",
+				},
+				{
+					ID:             uuid.MustParse("3ffadd67-d8cb-52d8-9561-155b641e132f"),
+					RoleID:         admin,
+					PermissionName: "harvest prediction",
+					PermissionPath: "/budidaya/:id/harvest-prediction",
+				},
+			},
+		}
+
+		getPricelistHistoryBudidaya := uuid.MustParse("3dd2ada5-0333-57e1-b356-f5d45dad8956")
+		getPricelistHistoryBudidayaPermission := model.Permission{
+			ID:   getPricelistHistoryBudidaya,
+			Code: "PM0048",
+			Name: "pricelist history budidaya",
+			Path: "/budidaya/:id/pricelist-history",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("4c14876f-74f3-5003-8e6d-07cacdf22348"),
+					RoleID:         seller,
+					PermissionName: "pricelist history budidaya",
+					PermissionPath: "/budidaya/:id/pricelist-history",
+				},
+				{
+					ID:             uuid.MustParse("3701cff3-7c38-5055-88c7-2c87cd57dbf0"),
+					RoleID:         admin,
+					PermissionName: "pricelist history budidaya",
+					PermissionPath: "/budidaya/:id/pricelist-history",
+				},
+			},
+		}
+
+		assignReviewerPond := uuid.MustParse("ef438b06-5d8a-5d19-a5aa-5ca950900536")
+		assignReviewerPondPermission := model.Permission{
+			ID:   assignReviewerPond,
+			Code: "PM0049",
+			Name: "assign reviewer pond",
+			Path: "/assign-reviewer-pond",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("b5a3e921-c4da-54d7-9c3d-0af45e5a070b"),
+					RoleID:         admin,
+					PermissionName: "assign reviewer pond",
+					PermissionPath: "/assign-reviewer-pond",
+				},
+			},
+		}
+
+		reviewBerkasPond := uuid.MustParse("60853bcf-6f29-523d-ab96-0066d3969295")
+		reviewBerkasPondPermission := model.Permission{
+			ID:   reviewBerkasPond,
+			Code: "PM0050",
+			Name: "review berkas pond",
+			Path: "/review-berkas-pond",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("419079ce-822e-525b-89fc-dfe843a00543"),
+					RoleID:         admin,
+					PermissionName: "review berkas pond",
+					PermissionPath: "/review-berkas-pond",
+				},
+			},
+		}
+
+		listReviewNote := uuid.MustParse("f1b983f9-372b-5b75-a3f8-ee7fecd27e68")
+		listReviewNotePermission := model.Permission{
+			ID:   listReviewNote,
+			Code: "PM0051",
+			Name: "list review note pond",
+			Path: "/pond/:id/review-notes",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("775c62a1-5f85-58ff-adf5-4dbcdf8e30d3"),
+					RoleID:         admin,
+					PermissionName: "list review note pond",
+					PermissionPath: "/pond/:id/review-notes",
+				},
+				{
+					ID:             uuid.MustParse("16369c9f-4112-516a-95a2-65f7dac50300"),
+					RoleID:         seller,
+					PermissionName: "list review note pond",
+					PermissionPath: "/pond/:id/review-notes",
+				},
+			},
+		}
+
+		listNotification := uuid.MustParse("f274c877-a1c5-5074-867b-ebd2b127d3b2")
+		listNotificationPermission := model.Permission{
+			ID:   listNotification,
+			Code: "PM0052",
+			Name: "list notification",
+			Path: "/notification",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("1e297399-c821-5eb2-8940-e3964946804e"),
+					RoleID:         admin,
+					PermissionName: "list notification",
+					PermissionPath: "/notification",
+				},
+				{
+					ID:             uuid.MustParse("7bf8f24a-3f38-534c-a8f8-a4a73f883e0a"),
+					RoleID:         seller,
+					PermissionName: "list notification",
+					PermissionPath: "/notification",
+				},
+				{
+					ID:             uuid.MustParse("09bbc26b-42e4-57b6-92d6-97120b88e294"),
+					RoleID:         buyer,
+					PermissionName: "list notification",
+					PermissionPath: "/notification",
+				},
+			},
+		}
+
+		readNotification := uuid.MustParse("0d1cd7d3-f553-5554-bc34-b648347048b9")
+		readNotificationPermission := model.Permission{
+			ID:   readNotification,
+			Code: "PM0053",
+			Name: "read notification",
+			Path: "/read-notification",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("89e697d6-9bd2-5e31-8bcd-e2c193d49f3f"),
+					RoleID:         admin,
+					PermissionName: "read notification",
+					PermissionPath: "/read-notification",
+				},
+				{
+					ID:             uuid.MustParse("73997a7c-a7e9-5f06-a6be-bbf78b6d9b90"),
+					RoleID:         seller,
+					PermissionName: "read notification",
+					PermissionPath: "/read-notification",
+				},
+				{
+					ID:             uuid.MustParse("7634070a-97ea-5460-8a85-37b2c761d4d5"),
+					RoleID:         buyer,
+					PermissionName: "read notification",
+					PermissionPath: "/read-notification",
+				},
+			},
+		}
+
+		createPool := uuid.MustParse("59e95935-fade-599d-b4c3-72d424e44a3c")
+		createPoolPermission := model.Permission{
+			ID:   createPool,
+			Code: "PM0054",
+			Name: "create pool",
+			Path: "/create-pool",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("6dc48b9c-0bf5-5f09-89e3-9cf6b4b0a612"),
+					RoleID:         seller,
+					PermissionName: "create pool",
+					PermissionPath: "/create-pool",
+				},
+			},
+		}
+
+		updatePool := uuid.MustParse("3fef9e1a-e82d-5042-ad6d-3fa99da623b5")
+		updatePoolPermission := model.Permission{
+			ID:   updatePool,
+			Code: "PM0055",
+			Name: "update pool",
+			Path: "/update-pool",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("0393b7f9-294f-57b0-8a0d-c7d07549d43a"),
+					RoleID:         seller,
+					PermissionName: "update pool",
+					PermissionPath: "/update-pool",
+				},
+			},
+		}
+
+		archivePool := uuid.MustParse("8e41b7ce-d8f1-5a56-a9b4-83d68974ad16")
+		archivePoolPermission := model.Permission{
+			ID:   archivePool,
+			Code: "PM0056",
+			Name: "archive pool",
+			Path: "/archive-pool",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("1b77ef7b-b621-597d-8908-960caf285991"),
+					RoleID:         seller,
+					PermissionName: "archive pool",
+					PermissionPath: "/archive-pool",
+				},
+			},
+		}
+
+		createTeam := uuid.MustParse("83051da1-6463-5d5d-af24-95c6ce95966f")
+		createTeamPermission := model.Permission{
+			ID:   createTeam,
+			Code: "PM0057",
+			Name: "create team",
+			Path: "/create-team",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("0bfadb7e-bdbc-5bb3-9a5a-b996bdb0c28d"),
+					RoleID:         seller,
+					PermissionName: "create team",
+					PermissionPath: "/create-team",
+				},
+			},
+		}
+
+		inviteTeamMember := uuid.MustParse("c0287c57-7f01-540c-b0e5-5f856de85a4c")
+		inviteTeamMemberPermission := model.Permission{
+			ID:   inviteTeamMember,
+			Code: "PM0058",
+			Name: "invite team member",
+			Path: "/invite-team-member",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("5e882998-b9d7-5fac-a98f-9b611058afad"),
+					RoleID:         seller,
+					PermissionName: "invite team member",
+					PermissionPath: "/invite-team-member",
+				},
+			},
+		}
+
+		acceptTeamInvitation := uuid.MustParse("4d24f1e3-0881-5f0a-987e-04e60c565639")
+		acceptTeamInvitationPermission := model.Permission{
+			ID:   acceptTeamInvitation,
+			Code: "PM0059",
+			Name: "accept team invitation",
+			Path: "/accept-team-invitation",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("82f0a708-51f1-5660-9673-03bf5ebd5298"),
+					RoleID:         seller,
+					PermissionName: "accept team invitation",
+					PermissionPath: "/accept-team-invitation",
+				},
+				{
+					ID:             uuid.MustParse("31b52e00-20c6-537f-a371-2261d59109a2"),
+					RoleID:         buyer,
+					PermissionName: "accept team invitation",
+					PermissionPath: "/accept-team-invitation",
+				},
+			},
+		}
+
+		removeTeamMember := uuid.MustParse("e9cdfd27-d4bd-5772-ab96-c08d5d3681fc")
+		removeTeamMemberPermission := model.Permission{
+			ID:   removeTeamMember,
+			Code: "PM0060",
+			Name: "remove team member",
+			Path: "/remove-team-member",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("837b00ef-6834-5e39-88e1-ae4b648f55c6"),
+					RoleID:         seller,
+					PermissionName: "remove team member",
+					PermissionPath: "/remove-team-member",
+				},
+				{
+					ID:             uuid.MustParse("7d3b3938-aebf-5a49-a967-b51709f490f5"),
+					RoleID:         buyer,
+					PermissionName: "remove team member",
+					PermissionPath: "/remove-team-member",
+				},
+			},
+		}
+
+		listTeam := uuid.MustParse("2e9ac8b2-b2d7-5fe0-bb07-2c27332ca4f1")
+		listTeamPermission := model.Permission{
+			ID:   listTeam,
+			Code: "PM0061",
+			Name: "list team",
+			Path: "/team",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("c6c280c2-10c1-505f-b435-be309eb11160"),
+					RoleID:         seller,
+					PermissionName: "list team",
+					PermissionPath: "/team",
+				},
+				{
+					ID:             uuid.MustParse("9f10ca14-9195-5ace-b892-46b2727d7da0"),
+					RoleID:         buyer,
+					PermissionName: "list team",
+					PermissionPath: "/team",
+				},
+			},
+		}
+
+		detailTeam := uuid.MustParse("4993c837-e997-5b61-a897-d69fb32d37cf")
+		detailTeamPermission := model.Permission{
+			ID:   detailTeam,
+			Code: "PM0062",
+			Name: "detail team",
+			Path: "/team/:id",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("c7cadcb6-23c0-5793-a8e7-0a43f0033828"),
+					RoleID:         seller,
+					PermissionName: "detail team",
+					PermissionPath: "/team/:id",
+				},
+				{
+					ID:             uuid.MustParse("2bc13e9b-fcba-5401-81e8-a0718dc15da3"),
+					RoleID:         buyer,
+					PermissionName: "detail team",
+					PermissionPath: "/team/:id",
+				},
+			},
+		}
+
+		listTeamInvitation := uuid.MustParse("77ecfd2e-6ee6-5b46-bd2b-95a353596aab")
+		listTeamInvitationPermission := model.Permission{
+			ID:   listTeamInvitation,
+			Code: "PM0063",
+			Name: "list team invitation",
+			Path: "/team-invitation",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("e87474a3-49b2-5ad6-988b-c34d60c94ee2"),
+					RoleID:         seller,
+					PermissionName: "list team invitation",
+					PermissionPath: "/team-invitation",
+				},
+				{
+					ID:             uuid.MustParse("81ee3594-e0eb-5d6c-978d-9ebad50ad946"),
+					RoleID:         buyer,
+					PermissionName: "list team invitation",
+					PermissionPath: "/team-invitation",
+				},
+			},
+		}
+
+		listMyPond := uuid.MustParse("87d0ce44-d5b4-5050-89af-b3c6d6077203")
+		listMyPondPermission := model.Permission{
+			ID:   listMyPond,
+			Code: "PM0064",
+			Name: "list my pond",
+			Path: "/my-pond",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("19c4ccd4-442a-5bce-97bd-22da2df7002e"),
+					RoleID:         seller,
+					PermissionName: "list my pond",
+					PermissionPath: "/my-pond",
+				},
+			},
+		}
+
+		selectPond := uuid.MustParse("4c078794-61b2-579a-853a-5c4dd3e55810")
+		selectPondPermission := model.Permission{
+			ID:   selectPond,
+			Code: "PM0065",
+			Name: "select pond",
+			Path: "/select-pond",
+			RolePermission: []*model.RolePermission{
+				{
+					ID:             uuid.MustParse("051dfb68-4dc9-507b-9598-c007662c0e25"),
+					RoleID:         seller,
+					PermissionName: "select pond",
+					PermissionPath: "/select-pond",
+				},
+			},
+		}
+
 		permission = append(permission,
 			permissionProfile,
 			createOrderPermission,
@@ -562,6 +1469,50 @@ func Migrate(db *gorm.DB, flag string) error {
 			getOrderSuccessPermission,
 			updateBudidayaWithPricelistPermission,
 			resubmissionPondPermission,
+			updateOrderStatusPermission,
+			getOrderStatusHistoryPermission,
+			createPaymentPermission,
+			getOrderDetailPermission,
+			updateOrderPermission,
+			addCartItemPermission,
+			updateCartItemPermission,
+			checkoutPermission,
+			getCartPermission,
+			getOrderInvoicePermission,
+			getSalesRevenuePermission,
+			getSalesFishSpeciesPermission,
+			getSalesTopBuyerPermission,
+			getSalesSummaryPermission,
+			createFeedingLogPermission,
+			createMortalityLogPermission,
+			createSamplingLogPermission,
+			getJournalBudidayaPermission,
+			createMultipleWaterQualityPermission,
+			updateWaterQualityRangePermission,
+			getWaterQualityPoolPermission,
+			createHarvestPermission,
+			getHarvestBudidayaPermission,
+			updateStatusBudidayaPermission,
+			updateGrowthCurvePermission,
+			getHarvestPredictionPermission,
+			getPricelistHistoryBudidayaPermission,
+			assignReviewerPondPermission,
+			reviewBerkasPondPermission,
+			listReviewNotePermission,
+			listNotificationPermission,
+			readNotificationPermission,
+			createPoolPermission,
+			updatePoolPermission,
+			archivePoolPermission,
+			createTeamPermission,
+			inviteTeamMemberPermission,
+			acceptTeamInvitationPermission,
+			removeTeamMemberPermission,
+			listTeamPermission,
+			detailTeamPermission,
+			listTeamInvitationPermission,
+			listMyPondPermission,
+			selectPondPermission,
 		)
 
 		db.Save(&permission)
@@ -80,32 +80,35 @@ type Team struct {
 	DistrictID uuid.UUID `gorm:"size:256"`
 	Detail     string
 	Note       string
+	OwnerID    uuid.UUID `gorm:"size:256"`
 	ListPond   []*Pond
+	ListMember []*TeamMember
 	orm.OrmModel
 }
 
 type Pond struct {
-	ID            uuid.UUID `gorm:"primaryKey,size:256" json:"id"`
-	UserID        uuid.UUID `gorm:"size:256"`
-	User          User      `json:"user"`
-	OwnerName     string    `json:"ownerName"`
-	Name          string    `json:"name"`
-	CountryID     uuid.UUID `gorm:"size:256" json:"countryID"`
-	ProvinceID    uuid.UUID `gorm:"size:256" json:"provinceID"`
-	CityID        uuid.UUID `gorm:"size:256" json:"cityID"`
-	DistrictID    uuid.UUID `gorm:"size:256" json:"districtID"`
-	DetailAddress string    `json:"detailAddress"`
-	NoteAddress   string    `json:"noteAddress"`
-	Type          string    `json:"type"`
-	Latitude      float64   `json:"latitude"`
-	Longitude     float64   `json:"longitude"`
-	TeamID        uuid.UUID `gorm:"size:256" json:"teamID"`
-	Team          Team      `json:"team"`
-	Status        string    `json:"status"`
-	Image         string    `json:"image"`
-	Reasons       string    `json:"reasons"`
-	ListPool      []Pool    `json:"listPool"`
-	ListBerkas    []Berkas  `json:"berkas"`
+	ID            uuid.UUID  `gorm:"primaryKey,size:256" json:"id"`
+	UserID        uuid.UUID  `gorm:"size:256"`
+	User          User       `json:"user"`
+	OwnerName     string     `json:"ownerName"`
+	Name          string     `json:"name"`
+	CountryID     uuid.UUID  `gorm:"size:256" json:"countryID"`
+	ProvinceID    uuid.UUID  `gorm:"size:256" json:"provinceID"`
+	CityID        uuid.UUID  `gorm:"size:256" json:"cityID"`
+	DistrictID    uuid.UUID  `gorm:"size:256" json:"districtID"`
+	DetailAddress string     `json:"detailAddress"`
+	NoteAddress   string     `json:"noteAddress"`
+	Type          string     `json:"type"`
+	Latitude      float64    `json:"latitude"`
+	Longitude     float64    `json:"longitude"`
+	TeamID        uuid.UUID  `gorm:"size:256" json:"teamID"`
+	Team          Team       `json:"team"`
+	Status        string     `json:"status"`
+	Image         string     `json:"image"`
+	Reasons       string     `json:"reasons"`
+	ReviewerID    *uuid.UUID `gorm:"size:256" json:"reviewerID"`
+	ListPool      []Pool     `json:"listPool"`
+	ListBerkas    []Berkas   `json:"berkas"`
 	orm.OrmModel
 }
 
@@ -115,17 +118,27 @@ type Berkas struct {
 	Pond   Pond
 	Name   string `json:"name"`
 	File   string `json:"file"`
+	// the existing berkas is required and reviewed again
+	Required      bool       `gorm:"default:true" json:"required"`
+	ReviewStatus  string     `gorm:"default:pending" json:"reviewStatus"`
+	ReviewComment string     `json:"reviewComment"`
+	ReviewedBy    *uuid.UUID `gorm:"size:256" json:"reviewedBy"`
+	ReviewedAt    *time.Time `json:"reviewedAt"`
 	orm.OrmModel
 }
 
 type Pool struct {
-	ID           uuid.UUID `gorm:"primaryKey,size:256" json:"id"`
-	PondID       uuid.UUID `gorm:"size:256" json:"pondID"`
-	Pond         Pond      `json:"pond"`
-	Name         string    `json:"name"`
-	Long         float64   `json:"long"`
-	Wide         float64   `json:"wide"`
-	Image        string    `json:"image"`
+	ID           uuid.UUID  `gorm:"primaryKey,size:256" json:"id"`
+	PondID       uuid.UUID  `gorm:"size:256" json:"pondID"`
+	Pond         Pond       `json:"pond"`
+	Name         string     `json:"name"`
+	Long         float64    `json:"long"`
+	Wide         float64    `json:"wide"`
+	Image        string     `json:"image"`
+	Depth        float64    `json:"depth"`
+	WaterSource  string     `json:"waterSource"`
+	PoolType     string     `json:"poolType"`
+	ArchivedAt   *time.Time `json:"archivedAt"`
 	ListBudidaya []*Budidaya
 	orm.OrmModel
 }
@@ -146,16 +159,29 @@ type Budidaya struct {
 	EstPrice        int
 	Status          string
 	Sold            int
+	Reserved        int
+	SeedCount       int
+	SeedWeight      float64
+	SeedAge         int
+	SeedSize        float64
+	SeedSupplier    string
+	StockingDensity float64
+	TotalFeed       float64
+	TotalMortality  int
+	AverageWeight   float64
 	PriceList       []*PriceList
 	orm.OrmModel
 }
 
 type PriceList struct {
-	ID         uuid.UUID `gorm:"primaryKey,size:256"`
-	BudidayaID uuid.UUID `gorm:"size:256"`
-	Budidaya   Budidaya
-	Limit      int
-	Price      int
+	ID            uuid.UUID `gorm:"primaryKey,size:256"`
+	BudidayaID    uuid.UUID `gorm:"size:256"`
+	Budidaya      Budidaya
+	Limit         int
+	Price         int
+	PreviousID    *uuid.UUID `gorm:"size:256"`
+	EffectiveFrom *time.Time
+	EffectiveTo   *time.Time
 	orm.OrmModel
 }
 
@@ -164,25 +190,35 @@ type FishSpecies struct {
 	Name     string
 	Asal     string
 	Budidaya []*Budidaya
+	// von Bertalanffy growth parameter, the weight is in gram and the growth rate is per day
+	AsymptoticWeight float64
+	GrowthRate       float64
+	HarvestWeight    float64
+	GrowthDeviation  float64
+	MaxDensity       float64
 	orm.OrmModel
 }
 
 type Order struct {
-	ID          uuid.UUID `gorm:"primaryKey,size:256"`
-	Code        string
-	PondID      uuid.UUID `gorm:"size:256"`
-	Pond        Pond
-	BudidayaID  uuid.UUID `gorm:"size:256"`
-	Budidaya    model.Budidaya
-	UserID      uuid.UUID `gorm:"size:256"`
-	User        User
-	Qty         int
-	BookingDate *time.Time
-	PricelistID uuid.UUID `gorm:"size:256"`
-	Pricelist   model.PriceList
-	Price       float64
-	Ammout      float64
-	Status      string
+	ID            uuid.UUID `gorm:"primaryKey,size:256"`
+	Code          string
+	OrderGroupID  *uuid.UUID `gorm:"size:256"`
+	PondID        uuid.UUID  `gorm:"size:256"`
+	Pond          Pond
+	BudidayaID    uuid.UUID `gorm:"size:256"`
+	Budidaya      model.Budidaya
+	UserID        uuid.UUID `gorm:"size:256"`
+	User          User
+	Qty           int
+	BookingDate   *time.Time
+	ReservedUntil *time.Time
+	PricelistID   uuid.UUID `gorm:"size:256"`
+	Pricelist     model.PriceList
+	// snapshot of the tier when the order is priced
+	PricelistLimit int
+	Price          float64
+	Ammout         float64
+	Status         string
 	orm.OrmModel
 }
 
@@ -230,3 +266,207 @@ type Banner struct {
 	Description string
 	orm.OrmModel
 }
+
+type OrderStatusHistory struct {
+	ID             uuid.UUID `gorm:"primaryKey,size:256"`
+	OrderID        uuid.UUID `gorm:"size:256"`
+	Order          Order
+	PreviousStatus string
+	Status         string
+	Actor          string
+	Source         string
+	Reason         string
+	orm.OrmModel
+}
+
+type Payment struct {
+	ID         uuid.UUID `gorm:"primaryKey,size:256"`
+	OrderID    uuid.UUID `gorm:"size:256"`
+	Order      Order
+	Provider   string
+	ExternalID string `gorm:"index"`
+	Amount     float64
+	Status     string
+	PaymentUrl string
+	ExpiredAt  time.Time
+	PaidAt     *time.Time
+	RefundID   string
+	orm.OrmModel
+}
+
+type OrderGroup struct {
+	ID          uuid.UUID `gorm:"primaryKey,size:256"`
+	Code        string
+	UserID      uuid.UUID `gorm:"size:256"`
+	User        User
+	PondID      uuid.UUID `gorm:"size:256"`
+	Pond        Pond
+	BookingDate *time.Time
+	Ammout      float64
+	Orders      []*Order
+	orm.OrmModel
+}
+
+type Cart struct {
+	ID     uuid.UUID `gorm:"primaryKey,size:256"`
+	UserID uuid.UUID `gorm:"size:256"`
+	User   User
+	PondID uuid.UUID `gorm:"size:256"`
+	Pond   Pond
+	Items  []*CartItem
+	orm.OrmModel
+}
+
+type CartItem struct {
+	ID         uuid.UUID `gorm:"primaryKey,size:256"`
+	CartID     uuid.UUID `gorm:"size:256"`
+	Cart       Cart
+	BudidayaID uuid.UUID `gorm:"size:256"`
+	Budidaya   Budidaya
+	Qty        int
+	orm.OrmModel
+}
+
+type IdempotencyKey struct {
+	ID           uuid.UUID `gorm:"primaryKey,size:256"`
+	UserID       uuid.UUID `gorm:"size:256;uniqueIndex:idx_idempotency_user_key"`
+	Key          string    `gorm:"column:idempotency_key;size:256;uniqueIndex:idx_idempotency_user_key"`
+	Path         string
+	RequestHash  string
+	ResponseHash string
+	Response     string
+	StatusCode   int
+	CreatedAt    time.Time
+}
+
+type FeedingLog struct {
+	ID         uuid.UUID `gorm:"primaryKey,size:256"`
+	BudidayaID uuid.UUID `gorm:"size:256"`
+	Budidaya   Budidaya
+	Date       time.Time
+	FeedType   string
+	Qty        float64
+	Note       string
+	orm.OrmModel
+}
+
+type MortalityLog struct {
+	ID         uuid.UUID `gorm:"primaryKey,size:256"`
+	BudidayaID uuid.UUID `gorm:"size:256"`
+	Budidaya   Budidaya
+	Date       time.Time
+	Count      int
+	Cause      string
+	Note       string
+	orm.OrmModel
+}
+
+type SamplingLog struct {
+	ID            uuid.UUID `gorm:"primaryKey,size:256"`
+	BudidayaID    uuid.UUID `gorm:"size:256"`
+	Budidaya      Budidaya
+	Date          time.Time
+	SampleCount   int
+	AverageWeight float64
+	AverageLength float64
+	Note          string
+	orm.OrmModel
+}
+
+type WaterQuality struct {
+	ID              uuid.UUID `gorm:"primaryKey,size:256"`
+	PondID          uuid.UUID `gorm:"size:256"`
+	Pond            Pond
+	PoolID          uuid.UUID `gorm:"size:256"`
+	Pool            Pool
+	BudidayaID      *uuid.UUID `gorm:"size:256"`
+	Budidaya        *Budidaya
+	MeasuredAt      time.Time `gorm:"index"`
+	PH              *float64
+	DissolvedOxygen *float64
+	Temperature     *float64
+	Ammonia         *float64
+	Salinity        *float64
+	orm.OrmModel
+}
+
+type WaterQualityRange struct {
+	ID            uuid.UUID `gorm:"primaryKey,size:256"`
+	FishSpeciesID uuid.UUID `gorm:"size:256"`
+	FishSpecies   FishSpecies
+	Parameter     string
+	Min           *float64
+	Max           *float64
+	orm.OrmModel
+}
+
+type WaterQualityAlert struct {
+	ID             uuid.UUID `gorm:"primaryKey,size:256"`
+	WaterQualityID uuid.UUID `gorm:"size:256"`
+	WaterQuality   WaterQuality
+	PoolID         uuid.UUID  `gorm:"size:256"`
+	BudidayaID     *uuid.UUID `gorm:"size:256"`
+	Parameter      string
+	Value          float64
+	Min            *float64
+	Max            *float64
+	orm.OrmModel
+}
+
+type Harvest struct {
+	ID           uuid.UUID `gorm:"primaryKey,size:256"`
+	BudidayaID   uuid.UUID `gorm:"size:256"`
+	Budidaya     Budidaya
+	PoolID       uuid.UUID `gorm:"size:256"`
+	Date         time.Time
+	ActualTonase float64
+	Sold         int
+	Leftover     float64
+	Note         string
+	Grades       []*HarvestGrade
+	orm.OrmModel
+}
+
+type HarvestGrade struct {
+	ID        uuid.UUID `gorm:"primaryKey,size:256"`
+	HarvestID uuid.UUID `gorm:"size:256"`
+	Grade     string
+	Qty       float64
+	orm.OrmModel
+}
+
+type ReviewNote struct {
+	ID     uuid.UUID `gorm:"primaryKey,size:256" json:"id"`
+	PondID uuid.UUID `gorm:"size:256" json:"pondID"`
+	Pond   Pond
+	UserID uuid.UUID `gorm:"size:256" json:"userID"`
+	User   User
+	Status string `json:"status"`
+	Note   string `json:"note"`
+	orm.OrmModel
+}
+
+type Notification struct {
+	ID     uuid.UUID `gorm:"primaryKey,size:256"`
+	UserID uuid.UUID `gorm:"size:256;index"`
+	User   User
+	Type   string `gorm:"size:64"`
+	Title  string `gorm:"size:256"`
+	Body   string `gorm:"size:1024"`
+	Data   string
+	ReadAt *time.Time
+	orm.OrmModel
+}
+
+type TeamMember struct {
+	ID       uuid.UUID `gorm:"primaryKey,size:256"`
+	TeamID   uuid.UUID `gorm:"size:256"`
+	Team     Team
+	UserID   *uuid.UUID `gorm:"size:256"`
+	User     *User
+	Email    string `gorm:"size:256"`
+	Role     string `gorm:"size:256"`
+	Status   string `gorm:"size:256"`
+	JoinedAt *time.Time
+	orm.OrmModel
+}
@@ -0,0 +1,18 @@
+package notificationhttp
+
+import (
+	notificationconfig "github.com/e-fish/api/notification_http/notification_config"
+	"github.com/e-fish/api/pkg/common/helper/restsvr"
+)
+
+func NewNotificationHttp() {
+	var (
+		ginEngine = restsvr.GetGinRoute()
+		conf      = notificationconfig.GetConfig()
+	)
+
+	newRoute(route{
+		conf: *conf,
+		gin:  ginEngine,
+	})
+}
@@ -0,0 +1,54 @@
+package notificationhandler
+
+import (
+	"strconv"
+
+	notificationconfig "github.com/e-fish/api/notification_http/notification_config"
+	notificationservice "github.com/e-fish/api/notification_http/notification_service"
+	"github.com/e-fish/api/pkg/common/helper/restsvr"
+	"github.com/e-fish/api/pkg/common/infra/orm"
+	"github.com/e-fish/api/pkg/domain/notification/model"
+	"github.com/gin-gonic/gin"
+)
+
+type Handler struct {
+	Conf    notificationconfig.NotificationConfig
+	Service notificationservice.Service
+}
+
+func (h *Handler) GetListNotification(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		res = new(restsvr.HttpResponse)
+	)
+
+	defer restsvr.ResponsJson(c, res)
+
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	page, _ := strconv.Atoi(c.Query("page"))
+
+	result, err := h.Service.GetListNotification(ctx, orm.Paginantion{
+		Limit: limit,
+		Page:  page,
+	})
+	res.Add(result, err)
+}
+
+func (h *Handler) ReadNotification(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req model.ReadNotificationInput
+		res = new(restsvr.HttpResponse)
+	)
+
+	defer restsvr.ResponsJson(c, res)
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.ReadNotification(ctx, req)
+	res.Add(result, err)
+}
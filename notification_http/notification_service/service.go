@@ -0,0 +1,55 @@
+package notificationservice
+
+import (
+	"context"
+
+	notificationconfig "github.com/e-fish/api/notification_http/notification_config"
+	"github.com/e-fish/api/pkg/common/helper/logger"
+	"github.com/e-fish/api/pkg/common/infra/orm"
+	"github.com/e-fish/api/pkg/domain/notification"
+	"github.com/e-fish/api/pkg/domain/notification/model"
+	"github.com/google/uuid"
+)
+
+func NewService(conf notificationconfig.NotificationConfig) Service {
+	// the notification is only listed and read here, the push message is sent by the domain that create it
+	repo, err := notification.NewRepo(conf.DbConfig, nil)
+	if err != nil {
+		logger.Fatal("###failed create notification service err: %v", err)
+	}
+
+	return Service{
+		conf: conf,
+		repo: repo,
+	}
+}
+
+type Service struct {
+	conf notificationconfig.NotificationConfig
+	repo notification.Repo
+}
+
+func (s *Service) ReadNotification(ctx context.Context, input model.ReadNotificationInput) (*uuid.UUID, error) {
+	command := s.repo.NewCommand(ctx)
+
+	result, err := command.ReadNotification(ctx, input)
+	if err != nil {
+		if err := command.Rollback(ctx); err != nil {
+			logger.ErrorWithContext(ctx, "failed rollback transaction read notification err: %v", err)
+		}
+		logger.ErrorWithContext(ctx, "failed read notification err: %v", err)
+		return nil, err
+	}
+
+	if err := command.Commit(ctx); err != nil {
+		logger.ErrorWithContext(ctx, "failed commit transaction read notification err: %v", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *Service) GetListNotification(ctx context.Context, input orm.Paginantion) (*model.ListNotificationOutput, error) {
+	query := s.repo.NewQuery()
+	return query.ReadNotificationByUserID(ctx, input)
+}
@@ -0,0 +1,27 @@
+package notificationhttp
+
+import (
+	notificationconfig "github.com/e-fish/api/notification_http/notification_config"
+	notificationhandler "github.com/e-fish/api/notification_http/notification_handler"
+	notificationservice "github.com/e-fish/api/notification_http/notification_service"
+	"github.com/e-fish/api/pkg/common/helper/ctxutil"
+	"github.com/gin-gonic/gin"
+)
+
+type route struct {
+	conf notificationconfig.NotificationConfig
+	gin  *gin.Engine
+}
+
+func newRoute(ro route) {
+	ginEngine := ro.gin
+
+	service := notificationservice.NewService(ro.conf)
+	handler := notificationhandler.Handler{
+		Conf:    ro.conf,
+		Service: service,
+	}
+
+	ginEngine.GET("/notification", ctxutil.Authorization(), handler.GetListNotification)
+	ginEngine.POST("/read-notification", ctxutil.Authorization(), handler.ReadNotification)
+}
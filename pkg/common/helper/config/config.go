@@ -25,3 +25,9 @@ type DbConfig struct {
 type FirebaseConfig struct {
 	FireBase string
 }
+
+type PaymentConfig struct {
+	Provider   string
+	SecretKey  string
+	PaymentUrl string
+}
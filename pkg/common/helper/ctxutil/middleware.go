@@ -50,7 +50,9 @@ func Authentication() gin.HandlerFunc {
 func Authorization() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		ctx := c.Request.Context()
-		path := c.Request.URL.Path
+		// use the registered route path, so the route with parameter like /order/:id
+		// is matched with the permission path
+		path := c.FullPath()
 
 		if !CanAccess(ctx, path) {
 			c.AbortWithStatusJSON(403, gin.H{
@@ -0,0 +1,14 @@
+package geo
+
+import "github.com/e-fish/api/pkg/common/helper/werror"
+
+var (
+	ErrInvalidPoint = werror.Error{
+		Code:    "ErrInvalidPoint",
+		Message: "invalid coordinate",
+	}
+	ErrInvalidRadius = werror.Error{
+		Code:    "ErrInvalidRadius",
+		Message: "invalid radius",
+	}
+)
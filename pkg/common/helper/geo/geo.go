@@ -0,0 +1,103 @@
+package geo
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/e-fish/api/pkg/common/helper/werror"
+)
+
+// EarthRadiusKm is the mean radius of the earth used by the haversine formula
+const EarthRadiusKm = 6371.0
+
+type Point struct {
+	Latitude  float64
+	Longitude float64
+}
+
+func (p Point) Validate() error {
+	errs := werror.NewError("invalid point")
+
+	if p.Latitude < -90 || p.Latitude > 90 {
+		errs.Add(ErrInvalidPoint.AttacthDetail(map[string]any{"latitude": "must be between -90 and 90"}))
+	}
+	if p.Longitude < -180 || p.Longitude > 180 {
+		errs.Add(ErrInvalidPoint.AttacthDetail(map[string]any{"longitude": "must be between -180 and 180"}))
+	}
+
+	return errs.Return()
+}
+
+// Haversine is the great circle distance between two point in km
+func Haversine(a, b Point) float64 {
+	var (
+		lat1 = radians(a.Latitude)
+		lat2 = radians(b.Latitude)
+		dLat = lat2 - lat1
+		dLng = radians(b.Longitude - a.Longitude)
+	)
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * EarthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+type BoundingBox struct {
+	MinLatitude  float64
+	MaxLatitude  float64
+	MinLongitude float64
+	MaxLongitude float64
+}
+
+// NewBoundingBox is the box that contain the circle of the radius,
+// it is used to prefilter the row by the index before the exact distance is calculated.
+// the longitude use the full range when the box cross the pole or the antimeridian
+func NewBoundingBox(center Point, radiusKm float64) BoundingBox {
+	var (
+		deltaLat = degrees(radiusKm / EarthRadiusKm)
+		box      = BoundingBox{
+			MinLatitude:  math.Max(center.Latitude-deltaLat, -90),
+			MaxLatitude:  math.Min(center.Latitude+deltaLat, 90),
+			MinLongitude: -180,
+			MaxLongitude: 180,
+		}
+	)
+
+	if box.MinLatitude == -90 || box.MaxLatitude == 90 {
+		return box
+	}
+
+	deltaLng := degrees(math.Asin(math.Min(1, math.Sin(radiusKm/EarthRadiusKm)/math.Cos(radians(center.Latitude)))))
+	if center.Longitude-deltaLng >= -180 && center.Longitude+deltaLng <= 180 {
+		box.MinLongitude = center.Longitude - deltaLng
+		box.MaxLongitude = center.Longitude + deltaLng
+	}
+
+	return box
+}
+
+func (b BoundingBox) Contains(p Point) bool {
+	return p.Latitude >= b.MinLatitude && p.Latitude <= b.MaxLatitude &&
+		p.Longitude >= b.MinLongitude && p.Longitude <= b.MaxLongitude
+}
+
+// DistanceSQL is the haversine distance in km from the point to the coordinate column,
+// it only use the function that is available in postgres and mysql.
+// LEAST keep the value of ACOS in the domain when the point is the same as the coordinate
+func DistanceSQL(p Point, latitudeColumn, longitudeColumn string) string {
+	var (
+		lat = strconv.FormatFloat(p.Latitude, 'f', -1, 64)
+		lng = strconv.FormatFloat(p.Longitude, 'f', -1, 64)
+	)
+
+	return "(" + strconv.FormatFloat(EarthRadiusKm, 'f', -1, 64) + " * ACOS(LEAST(1, " +
+		"COS(RADIANS(" + lat + ")) * COS(RADIANS(" + latitudeColumn + ")) * COS(RADIANS(" + longitudeColumn + ") - RADIANS(" + lng + ")) + " +
+		"SIN(RADIANS(" + lat + ")) * SIN(RADIANS(" + latitudeColumn + ")))))"
+}
+
+func radians(degree float64) float64 {
+	return degree * math.Pi / 180
+}
+
+func degrees(radian float64) float64 {
+	return radian * 180 / math.Pi
+}
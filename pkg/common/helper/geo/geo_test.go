@@ -0,0 +1,57 @@
+package geo_test
+
+import (
+	"testing"
+
+	"github.com/e-fish/api/pkg/common/helper/geo"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	jakarta = geo.Point{Latitude: -6.2088, Longitude: 106.8456}
+	bandung = geo.Point{Latitude: -6.9175, Longitude: 107.6191}
+)
+
+func TestHaversine(t *testing.T) {
+	assert.InDelta(t, 116, geo.Haversine(jakarta, bandung), 2)
+	assert.Equal(t, float64(0), geo.Haversine(jakarta, jakarta))
+}
+
+func TestBoundingBox(t *testing.T) {
+	box := geo.NewBoundingBox(jakarta, 150)
+	assert.True(t, box.Contains(bandung))
+
+	box = geo.NewBoundingBox(jakarta, 50)
+	assert.False(t, box.Contains(bandung))
+
+	box = geo.NewBoundingBox(geo.Point{Latitude: 0, Longitude: 179.9}, 50)
+	assert.Equal(t, float64(-180), box.MinLongitude)
+	assert.Equal(t, float64(180), box.MaxLongitude)
+}
+
+func TestDistanceSQL(t *testing.T) {
+	sql := geo.DistanceSQL(jakarta, "ponds.latitude", "ponds.longitude")
+	assert.Contains(t, sql, "ACOS(LEAST(1, COS(RADIANS(-6.2088)) * COS(RADIANS(ponds.latitude))")
+}
+
+func TestParseNearby(t *testing.T) {
+	nearby, err := geo.ParseNearby("", "", "10")
+	assert.NoError(t, err)
+	assert.True(t, nearby.IsEmpty())
+
+	nearby, err = geo.ParseNearby("-6.2088", "106.8456", "150")
+	assert.NoError(t, err)
+
+	_, ok := nearby.Distance(bandung)
+	assert.True(t, ok)
+
+	nearby.RadiusKm = 50
+	_, ok = nearby.Distance(bandung)
+	assert.False(t, ok)
+
+	_, err = geo.ParseNearby("-100", "106.8456", "")
+	assert.Error(t, err)
+
+	_, err = geo.ParseNearby("abc", "106.8456", "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,70 @@
+package geo
+
+import (
+	"strconv"
+
+	"github.com/e-fish/api/pkg/common/helper/werror"
+)
+
+// Nearby is the location filter of the query, the filter is not used when the point is nil
+// and the radius 0 is unlimited, the result is only sorted by the distance
+type Nearby struct {
+	Point    *Point
+	RadiusKm float64
+}
+
+// ParseNearby read the nearby filter from the query param
+func ParseNearby(lat, lng, radiusKm string) (Nearby, error) {
+	var (
+		nearby = Nearby{}
+		errs   = werror.NewError("invalid nearby")
+	)
+
+	if lat == "" && lng == "" {
+		return nearby, nil
+	}
+
+	latitude, err := strconv.ParseFloat(lat, 64)
+	if err != nil {
+		errs.Add(ErrInvalidPoint.AttacthDetail(map[string]any{"lat": lat}))
+	}
+	longitude, err := strconv.ParseFloat(lng, 64)
+	if err != nil {
+		errs.Add(ErrInvalidPoint.AttacthDetail(map[string]any{"lng": lng}))
+	}
+	if radiusKm != "" {
+		nearby.RadiusKm, err = strconv.ParseFloat(radiusKm, 64)
+		if err != nil {
+			errs.Add(ErrInvalidRadius.AttacthDetail(map[string]any{"radiusKm": radiusKm}))
+		}
+	}
+
+	if err := errs.Return(); err != nil {
+		return nearby, err
+	}
+
+	nearby.Point = &Point{Latitude: latitude, Longitude: longitude}
+	return nearby, nearby.Validate()
+}
+
+func (n Nearby) IsEmpty() bool {
+	return n.Point == nil
+}
+
+func (n Nearby) Validate() error {
+	if n.IsEmpty() {
+		return nil
+	}
+
+	if n.RadiusKm < 0 {
+		return ErrInvalidRadius.AttacthDetail(map[string]any{"radiusKm": "can't be negative"})
+	}
+
+	return n.Point.Validate()
+}
+
+// Distance is the distance from the filter to the point, ok is false when the point is outside the radius
+func (n Nearby) Distance(p Point) (distance float64, ok bool) {
+	distance = Haversine(*n.Point, p)
+	return distance, n.RadiusKm == 0 || distance <= n.RadiusKm
+}
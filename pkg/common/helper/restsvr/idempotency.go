@@ -0,0 +1,202 @@
+package restsvr
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/e-fish/api/pkg/common/helper/ctxutil"
+	"github.com/e-fish/api/pkg/common/helper/logger"
+	"github.com/e-fish/api/pkg/common/helper/werror"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	IDEMPOTENCY_HEADER          = "Idempotency-Key"
+	IDEMPOTENCY_REPLAYED_HEADER = "Idempotent-Replayed"
+
+	// the in progress key older than the lock ttl is left by the request that never finished
+	IDEMPOTENCY_LOCK_TTL = time.Minute
+	// the stored response is only replayed until the key ttl
+	IDEMPOTENCY_KEY_TTL = time.Hour * 24
+)
+
+var (
+	ErrIdempotencyKeyReused = werror.Error{
+		Code:    "IdempotencyKeyReused",
+		Message: "idempotency key already used by another request",
+	}
+	ErrIdempotencyInProgress = werror.Error{
+		Code:    "IdempotencyInProgress",
+		Message: "request with the same idempotency key is still in progress",
+	}
+	ErrIdempotencyStore = werror.Error{
+		Code:    "IdempotencyStoreFailed",
+		Message: "unable to read the idempotency key",
+	}
+)
+
+type IdempotencyKey struct {
+	ID           uuid.UUID `gorm:"primaryKey,size:256"`
+	UserID       uuid.UUID `gorm:"size:256;uniqueIndex:idx_idempotency_user_key"`
+	Key          string    `gorm:"column:idempotency_key;size:256;uniqueIndex:idx_idempotency_user_key"`
+	Path         string
+	RequestHash  string
+	ResponseHash string
+	Response     string
+	StatusCode   int
+	CreatedAt    time.Time
+}
+
+func (i IdempotencyKey) expired(now time.Time) bool {
+	if i.Response == "" {
+		return now.Sub(i.CreatedAt) > IDEMPOTENCY_LOCK_TTL
+	}
+	return now.Sub(i.CreatedAt) > IDEMPOTENCY_KEY_TTL
+}
+
+type IdempotencyStore interface {
+	// GetIdempotencyKey return nil without error when the key is not exist
+	GetIdempotencyKey(ctx context.Context, userID uuid.UUID, key string) (*IdempotencyKey, error)
+	// CreateIdempotencyKey must return error when the key of the user already exist
+	CreateIdempotencyKey(ctx context.Context, data IdempotencyKey) error
+	UpdateIdempotencyKey(ctx context.Context, data IdempotencyKey) error
+	DeleteIdempotencyKey(ctx context.Context, id uuid.UUID) error
+}
+
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *responseRecorder) Write(data []byte) (int, error) {
+	r.body.Write(data)
+	return r.ResponseWriter.Write(data)
+}
+
+func (r *responseRecorder) WriteString(data string) (int, error) {
+	r.body.WriteString(data)
+	return r.ResponseWriter.WriteString(data)
+}
+
+func hash(data ...[]byte) string {
+	h := sha256.New()
+	for _, v := range data {
+		h.Write(v)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Idempotency replay the stored response when the user send the same Idempotency-Key,
+// so the client can retry the create request without creating the data twice.
+// only the success response is stored, the failed request can be retried with the same key.
+// the expired key is removed, so the key can be used again after the ttl
+func Idempotency(store IdempotencyStore) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var (
+			ctx       = c.Request.Context()
+			key       = c.GetHeader(IDEMPOTENCY_HEADER)
+			userID, _ = ctxutil.GetUserID(ctx)
+		)
+
+		if key == "" || userID == uuid.Nil {
+			c.Next()
+			return
+		}
+
+		abort := func(err error) {
+			res := new(HttpResponse)
+			res.Add(nil, err)
+			ResponsJson(c, res)
+			c.Abort()
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			abort(err)
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		requestHash := hash([]byte(c.Request.Method), []byte(c.FullPath()), body)
+
+		exist, err := store.GetIdempotencyKey(ctx, userID, key)
+		if err != nil {
+			abort(ErrIdempotencyStore.AttacthDetail(map[string]any{"error": err}))
+			return
+		}
+
+		if exist != nil && exist.expired(time.Now()) {
+			if err := store.DeleteIdempotencyKey(ctx, exist.ID); err != nil {
+				abort(ErrIdempotencyStore.AttacthDetail(map[string]any{"error": err}))
+				return
+			}
+			exist = nil
+		}
+
+		if exist != nil {
+			if exist.RequestHash != requestHash {
+				abort(ErrIdempotencyKeyReused)
+				return
+			}
+			if exist.Response == "" {
+				abort(ErrIdempotencyInProgress)
+				return
+			}
+
+			// the key stored before the status code is saved has no status code
+			status := exist.StatusCode
+			if status == 0 {
+				status = 200
+			}
+
+			c.Header(IDEMPOTENCY_REPLAYED_HEADER, "true")
+			c.Data(status, "application/json; charset=utf-8", []byte(exist.Response))
+			c.Abort()
+			return
+		}
+
+		record := IdempotencyKey{
+			ID:          uuid.New(),
+			UserID:      userID,
+			Key:         key,
+			Path:        c.FullPath(),
+			RequestHash: requestHash,
+			CreatedAt:   time.Now(),
+		}
+
+		// the unique key make sure only one request with the same key is processed
+		if err := store.CreateIdempotencyKey(ctx, record); err != nil {
+			abort(ErrIdempotencyInProgress.AttacthDetail(map[string]any{"error": err}))
+			return
+		}
+
+		recorder := &responseRecorder{ResponseWriter: c.Writer}
+		c.Writer = recorder
+
+		c.Next()
+
+		// the request context can be ended by the timeout
+		ctx = ctxutil.NewRequestWithOutTimeOut(ctx)
+
+		res := HttpResponse{}
+		if err := json.Unmarshal(recorder.body.Bytes(), &res); err != nil || res.Status != StatusSuccess {
+			if err := store.DeleteIdempotencyKey(ctx, record.ID); err != nil {
+				logger.ErrorWithContext(ctx, "failed delete idempotency key err: %v", err)
+			}
+			return
+		}
+
+		record.Response = recorder.body.String()
+		record.ResponseHash = hash(recorder.body.Bytes())
+		record.StatusCode = recorder.Status()
+		if err := store.UpdateIdempotencyKey(ctx, record); err != nil {
+			logger.ErrorWithContext(ctx, "failed save idempotency response err: %v", err)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package restsvr
+
+import (
+	"context"
+	"errors"
+
+	"github.com/e-fish/api/pkg/common/helper/config"
+	"github.com/e-fish/api/pkg/common/infra/orm"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+func NewIdempotencyStore(dbConfig config.DbConfig) (IdempotencyStore, error) {
+	db, err := orm.CreateConnetionDB(dbConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &idempotencyStore{db: db}, nil
+}
+
+type idempotencyStore struct {
+	db *gorm.DB
+}
+
+// GetIdempotencyKey implements IdempotencyStore.
+func (i *idempotencyStore) GetIdempotencyKey(ctx context.Context, userID uuid.UUID, key string) (*IdempotencyKey, error) {
+	data := IdempotencyKey{}
+
+	err := i.db.WithContext(ctx).Where("user_id = ? and idempotency_key = ?", userID, key).Take(&data).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &data, nil
+}
+
+// CreateIdempotencyKey implements IdempotencyStore.
+func (i *idempotencyStore) CreateIdempotencyKey(ctx context.Context, data IdempotencyKey) error {
+	return i.db.WithContext(ctx).Create(&data).Error
+}
+
+// UpdateIdempotencyKey implements IdempotencyStore.
+func (i *idempotencyStore) UpdateIdempotencyKey(ctx context.Context, data IdempotencyKey) error {
+	return i.db.WithContext(ctx).Where("id = ?", data.ID).Updates(&IdempotencyKey{
+		Response:     data.Response,
+		ResponseHash: data.ResponseHash,
+		StatusCode:   data.StatusCode,
+	}).Error
+}
+
+// DeleteIdempotencyKey implements IdempotencyStore.
+func (i *idempotencyStore) DeleteIdempotencyKey(ctx context.Context, id uuid.UUID) error {
+	return i.db.WithContext(ctx).Where("id = ?", id).Delete(&IdempotencyKey{}).Error
+}
@@ -0,0 +1,156 @@
+package restsvr_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/e-fish/api/pkg/common/helper/ctxutil"
+	"github.com/e-fish/api/pkg/common/helper/restsvr"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type memoryStore struct {
+	mut  sync.Mutex
+	data map[string]restsvr.IdempotencyKey
+}
+
+func (m *memoryStore) GetIdempotencyKey(ctx context.Context, userID uuid.UUID, key string) (*restsvr.IdempotencyKey, error) {
+	m.mut.Lock()
+	defer m.mut.Unlock()
+	if v, ok := m.data[userID.String()+key]; ok {
+		return &v, nil
+	}
+	return nil, nil
+}
+
+func (m *memoryStore) CreateIdempotencyKey(ctx context.Context, data restsvr.IdempotencyKey) error {
+	m.mut.Lock()
+	defer m.mut.Unlock()
+	if _, ok := m.data[data.UserID.String()+data.Key]; ok {
+		return errors.New("duplicate key")
+	}
+	m.data[data.UserID.String()+data.Key] = data
+	return nil
+}
+
+func (m *memoryStore) UpdateIdempotencyKey(ctx context.Context, data restsvr.IdempotencyKey) error {
+	m.mut.Lock()
+	defer m.mut.Unlock()
+	m.data[data.UserID.String()+data.Key] = data
+	return nil
+}
+
+func (m *memoryStore) DeleteIdempotencyKey(ctx context.Context, id uuid.UUID) error {
+	m.mut.Lock()
+	defer m.mut.Unlock()
+	for k, v := range m.data {
+		if v.ID == id {
+			delete(m.data, k)
+		}
+	}
+	return nil
+}
+
+func TestIdempotency(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var (
+		store   = &memoryStore{data: map[string]restsvr.IdempotencyKey{}}
+		userID  = uuid.New()
+		created = 0
+	)
+
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		c.Request = c.Request.WithContext(ctxutil.SetUserID(c.Request.Context(), userID))
+	})
+	r.POST("/create", restsvr.Idempotency(store), func(c *gin.Context) {
+		res := new(restsvr.HttpResponse)
+		defer restsvr.ResponsJson(c, res)
+		created++
+		res.Add(uuid.New(), nil)
+	})
+
+	send := func(key, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/create", bytes.NewBufferString(body))
+		req.Header.Set(restsvr.IDEMPOTENCY_HEADER, key)
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	first := send("key-1", `{"qty":1}`)
+	second := send("key-1", `{"qty":1}`)
+	assert.Equal(t, 1, created)
+	assert.Equal(t, first.Body.String(), second.Body.String())
+	assert.Equal(t, "true", second.Header().Get(restsvr.IDEMPOTENCY_REPLAYED_HEADER))
+
+	reused := send("key-1", `{"qty":2}`)
+	assert.Equal(t, 1, created)
+	assert.Contains(t, reused.Body.String(), restsvr.ErrIdempotencyKeyReused.Code)
+
+	send("key-2", `{"qty":1}`)
+	send("", `{"qty":1}`)
+	assert.Equal(t, 3, created)
+
+	// the request that never finished leave the key without response
+	stale := store.data[userID.String()+"key-2"]
+	stale.Response = ""
+	store.UpdateIdempotencyKey(context.Background(), stale)
+	inProgress := send("key-2", `{"qty":1}`)
+	assert.Equal(t, 3, created)
+	assert.Contains(t, inProgress.Body.String(), restsvr.ErrIdempotencyInProgress.Code)
+
+	stale.CreatedAt = time.Now().Add(-restsvr.IDEMPOTENCY_LOCK_TTL * 2)
+	store.UpdateIdempotencyKey(context.Background(), stale)
+	send("key-2", `{"qty":1}`)
+	assert.Equal(t, 4, created)
+
+	expired := store.data[userID.String()+"key-1"]
+	expired.CreatedAt = time.Now().Add(-restsvr.IDEMPOTENCY_KEY_TTL * 2)
+	store.UpdateIdempotencyKey(context.Background(), expired)
+	again := send("key-1", `{"qty":2}`)
+	assert.Equal(t, 5, created)
+	assert.Empty(t, again.Header().Get(restsvr.IDEMPOTENCY_REPLAYED_HEADER))
+}
+
+func TestIdempotencyReplayStatusCode(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var (
+		store  = &memoryStore{data: map[string]restsvr.IdempotencyKey{}}
+		userID = uuid.New()
+	)
+
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		c.Request = c.Request.WithContext(ctxutil.SetUserID(c.Request.Context(), userID))
+	})
+	r.POST("/create", restsvr.Idempotency(store), func(c *gin.Context) {
+		res := new(restsvr.HttpResponse)
+		res.Add(uuid.New(), nil)
+		c.JSON(http.StatusCreated, res)
+	})
+
+	send := func() *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/create", bytes.NewBufferString(`{"qty":1}`))
+		req.Header.Set(restsvr.IDEMPOTENCY_HEADER, "key-1")
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	first := send()
+	second := send()
+	assert.Equal(t, http.StatusCreated, first.Code)
+	assert.Equal(t, http.StatusCreated, second.Code)
+	assert.Equal(t, "true", second.Header().Get(restsvr.IDEMPOTENCY_REPLAYED_HEADER))
+}
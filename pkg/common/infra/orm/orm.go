@@ -167,6 +167,21 @@ func CommitTxn(ctx context.Context) error {
 	return err
 }
 
+// InTxn check the transaction of the ctx is not committed or rolled back yet
+func InTxn(ctx context.Context) bool {
+	txnID, withTransaction := ctxutil.GetTransactionID(ctx)
+	if !withTransaction {
+		return false
+	}
+
+	pool := getGormPool()
+	pool.mut.Lock()
+	defer pool.mut.Unlock()
+
+	_, exist := pool.txns[txnID]
+	return exist
+}
+
 func RollbackTxn(ctx context.Context) error {
 	txnID, withTransaction := ctxutil.GetTransactionID(ctx)
 	if !withTransaction {
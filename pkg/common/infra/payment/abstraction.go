@@ -0,0 +1,11 @@
+package payment
+
+import "context"
+
+type PaymentGateway interface {
+	Name() string
+	CreateInvoice(ctx context.Context, input InvoiceInput) (*Invoice, error)
+	QueryStatus(ctx context.Context, externalID string) (*Invoice, error)
+	HandleCallback(ctx context.Context, signature string, body []byte) (*CallbackData, error)
+	Refund(ctx context.Context, input RefundInput) (*RefundData, error)
+}
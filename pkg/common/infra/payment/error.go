@@ -0,0 +1,30 @@
+package payment
+
+import "github.com/e-fish/api/pkg/common/helper/werror"
+
+var (
+	ErrProviderNotSupported = werror.Error{
+		Code:    "PaymentProviderNotSupported",
+		Message: "payment provider is not supported",
+	}
+	ErrInvalidSignature = werror.Error{
+		Code:    "PaymentInvalidSignature",
+		Message: "signature of the payment callback is not valid",
+	}
+	ErrInvalidCallback = werror.Error{
+		Code:    "PaymentInvalidCallback",
+		Message: "unable to read the payment callback",
+	}
+	ErrFoundInvoice = werror.Error{
+		Code:    "PaymentFoundInvoice",
+		Message: "invoice not found",
+	}
+	ErrRefund = werror.Error{
+		Code:    "PaymentFailedRefund",
+		Message: "failed refund the payment",
+	}
+	ErrProviderEmpty = werror.Error{
+		Code:    "PaymentProviderEmpty",
+		Message: "payment provider is not configured",
+	}
+)
@@ -0,0 +1,154 @@
+package payment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FakeGateway is in-process payment provider,
+// used on development and test to run the payment flow without the real provider
+type FakeGateway struct {
+	secretKey  string
+	paymentUrl string
+
+	mut      sync.Mutex
+	invoices map[string]*Invoice
+	refunds  map[string]*RefundData
+}
+
+func NewFakeGateway(secretKey, paymentUrl string) *FakeGateway {
+	return &FakeGateway{
+		secretKey:  secretKey,
+		paymentUrl: paymentUrl,
+		invoices:   make(map[string]*Invoice),
+		refunds:    make(map[string]*RefundData),
+	}
+}
+
+// Name implements PaymentGateway.
+func (f *FakeGateway) Name() string {
+	return FAKE
+}
+
+// CreateInvoice implements PaymentGateway.
+func (f *FakeGateway) CreateInvoice(ctx context.Context, input InvoiceInput) (*Invoice, error) {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+
+	externalID := uuid.New().String()
+	invoice := &Invoice{
+		ExternalID:  externalID,
+		ReferenceID: input.ReferenceID,
+		Amount:      input.Amount,
+		Status:      PENDING,
+		PaymentUrl:  fmt.Sprintf("%s/%s", f.paymentUrl, externalID),
+		ExpiredAt:   input.ExpiredAt,
+	}
+	f.invoices[externalID] = invoice
+
+	result := *invoice
+	return &result, nil
+}
+
+// QueryStatus implements PaymentGateway.
+func (f *FakeGateway) QueryStatus(ctx context.Context, externalID string) (*Invoice, error) {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+
+	invoice, ok := f.invoices[externalID]
+	if !ok {
+		return nil, ErrFoundInvoice.AttacthDetail(map[string]any{"externalID": externalID})
+	}
+
+	if invoice.Status == PENDING && !invoice.ExpiredAt.IsZero() && time.Now().After(invoice.ExpiredAt) {
+		invoice.Status = EXPIRED
+	}
+
+	result := *invoice
+	return &result, nil
+}
+
+// HandleCallback implements PaymentGateway.
+func (f *FakeGateway) HandleCallback(ctx context.Context, signature string, body []byte) (*CallbackData, error) {
+	if !VerifySignature(f.secretKey, body, signature) {
+		return nil, ErrInvalidSignature
+	}
+
+	data := CallbackData{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return nil, ErrInvalidCallback.AttacthDetail(map[string]any{"error": err})
+	}
+
+	f.mut.Lock()
+	defer f.mut.Unlock()
+
+	if invoice, ok := f.invoices[data.ExternalID]; ok {
+		invoice.Status = data.Status
+	}
+
+	return &data, nil
+}
+
+// Refund implements PaymentGateway.
+// the refund with the same reference id return the previous refund, as the idempotency key of the real provider
+func (f *FakeGateway) Refund(ctx context.Context, input RefundInput) (*RefundData, error) {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+
+	if refund, ok := f.refunds[input.ReferenceID]; ok && input.ReferenceID != "" {
+		result := *refund
+		return &result, nil
+	}
+
+	invoice, ok := f.invoices[input.ExternalID]
+	if !ok {
+		return nil, ErrFoundInvoice.AttacthDetail(map[string]any{"externalID": input.ExternalID})
+	}
+
+	if invoice.Status != PAID {
+		return nil, ErrRefund.AttacthDetail(map[string]any{"status": invoice.Status})
+	}
+
+	invoice.Status = REFUNDED
+
+	refund := &RefundData{
+		ExternalID: input.ExternalID,
+		RefundID:   uuid.New().String(),
+		Amount:     input.Amount,
+		Status:     REFUNDED,
+	}
+	if input.ReferenceID != "" {
+		f.refunds[input.ReferenceID] = refund
+	}
+
+	result := *refund
+	return &result, nil
+}
+
+// Pay simulate the buyer pay the invoice,
+// return the callback body and signature as sent by the provider
+func (f *FakeGateway) Pay(ctx context.Context, externalID string) ([]byte, string, error) {
+	invoice, err := f.QueryStatus(ctx, externalID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	paidAt := time.Now()
+	body, err := json.Marshal(CallbackData{
+		ExternalID:  invoice.ExternalID,
+		ReferenceID: invoice.ReferenceID,
+		Amount:      invoice.Amount,
+		Status:      PAID,
+		PaidAt:      &paidAt,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body, Sign(f.secretKey, body), nil
+}
@@ -0,0 +1,95 @@
+package payment_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/e-fish/api/pkg/common/helper/config"
+	"github.com/e-fish/api/pkg/common/infra/payment"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFakeGateway(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		gateway = payment.NewFakeGateway("secret", "http://localhost/pay")
+	)
+
+	invoice, err := gateway.CreateInvoice(ctx, payment.InvoiceInput{
+		ReferenceID: "OC-2023",
+		Amount:      10000,
+		ExpiredAt:   time.Now().Add(time.Hour),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, payment.PENDING, invoice.Status)
+
+	body, signature, err := gateway.Pay(ctx, invoice.ExternalID)
+	assert.NoError(t, err)
+
+	t.Run("FailedInvalidSignature", func(t *testing.T) {
+		_, err := gateway.HandleCallback(ctx, "invalid", body)
+		assert.Error(t, err)
+		assert.True(t, payment.ErrInvalidSignature.Is(err))
+	})
+
+	t.Run("SuccessCallback", func(t *testing.T) {
+		data, err := gateway.HandleCallback(ctx, signature, body)
+		assert.NoError(t, err)
+		assert.Equal(t, payment.PAID, data.Status)
+		assert.Equal(t, invoice.Amount, data.Amount)
+
+		status, err := gateway.QueryStatus(ctx, invoice.ExternalID)
+		assert.NoError(t, err)
+		assert.Equal(t, payment.PAID, status.Status)
+	})
+
+	t.Run("SuccessRefund", func(t *testing.T) {
+		refund, err := gateway.Refund(ctx, payment.RefundInput{ExternalID: invoice.ExternalID, Amount: invoice.Amount})
+		assert.NoError(t, err)
+		assert.Equal(t, payment.REFUNDED, refund.Status)
+	})
+}
+
+func TestFakeGatewayRefundIdempotent(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		gateway = payment.NewFakeGateway("secret", "http://localhost/pay")
+	)
+
+	invoice, err := gateway.CreateInvoice(ctx, payment.InvoiceInput{ReferenceID: "OC-2024", Amount: 5000, ExpiredAt: time.Now().Add(time.Hour)})
+	assert.NoError(t, err)
+
+	body, signature, err := gateway.Pay(ctx, invoice.ExternalID)
+	assert.NoError(t, err)
+	_, err = gateway.HandleCallback(ctx, signature, body)
+	assert.NoError(t, err)
+
+	input := payment.RefundInput{ReferenceID: "payment-1", ExternalID: invoice.ExternalID, Amount: invoice.Amount}
+
+	first, err := gateway.Refund(ctx, input)
+	assert.NoError(t, err)
+
+	second, err := gateway.Refund(ctx, input)
+	assert.NoError(t, err)
+	assert.Equal(t, first.RefundID, second.RefundID)
+
+	input.ReferenceID = "payment-2"
+	_, err = gateway.Refund(ctx, input)
+	assert.Error(t, err)
+}
+
+func TestNewPaymentGateway(t *testing.T) {
+	_, err := payment.NewPaymentGateway(config.PaymentConfig{})
+	assert.Error(t, err)
+	assert.True(t, payment.ErrProviderEmpty.Is(err))
+
+	gateway, err := payment.NewPaymentGateway(config.PaymentConfig{Provider: payment.FAKE, SecretKey: "secret"})
+	assert.NoError(t, err)
+	assert.Equal(t, payment.FAKE, gateway.Name())
+
+	// the invoice of the gateway is found by the gateway of the other service with the same config
+	other, err := payment.NewPaymentGateway(config.PaymentConfig{Provider: payment.FAKE, SecretKey: "secret"})
+	assert.NoError(t, err)
+	assert.Same(t, gateway, other)
+}
@@ -0,0 +1,51 @@
+package payment
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+
+	"github.com/e-fish/api/pkg/common/helper/config"
+)
+
+var (
+	mut      sync.Mutex
+	gateways = make(map[config.PaymentConfig]PaymentGateway)
+)
+
+// NewPaymentGateway return the same gateway for the same config in the process,
+// so the invoice created by a service can be refunded by the other service
+func NewPaymentGateway(conf config.PaymentConfig) (PaymentGateway, error) {
+	mut.Lock()
+	defer mut.Unlock()
+
+	if gateway, ok := gateways[conf]; ok {
+		return gateway, nil
+	}
+
+	var gateway PaymentGateway
+	switch conf.Provider {
+	case "":
+		return nil, ErrProviderEmpty
+	case FAKE:
+		gateway = NewFakeGateway(conf.SecretKey, conf.PaymentUrl)
+	default:
+		return nil, ErrProviderNotSupported.AttacthDetail(map[string]any{"provider": conf.Provider})
+	}
+
+	gateways[conf] = gateway
+	return gateway, nil
+}
+
+// Sign create signature of the callback body with HMAC-SHA256
+func Sign(secretKey string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secretKey))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature compare the signature with the signature of the body
+func VerifySignature(secretKey string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secretKey, body)), []byte(signature))
+}
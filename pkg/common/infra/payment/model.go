@@ -0,0 +1,61 @@
+package payment
+
+import "time"
+
+// provider
+const (
+	FAKE = "fake"
+)
+
+// payment status
+const (
+	PENDING  = "pending"
+	PAID     = "paid"
+	EXPIRED  = "expired"
+	FAILED   = "failed"
+	REFUNDED = "refunded"
+	// REFUNDING is the payment that is waiting to be refunded by the provider after the transaction is committed
+	REFUNDING = "refunding"
+)
+
+// SIGNATURE_HEADER is the header of the callback request that contains the signature of the body
+const SIGNATURE_HEADER = "X-Callback-Signature"
+
+type InvoiceInput struct {
+	ReferenceID string
+	Amount      float64
+	Description string
+	ExpiredAt   time.Time
+}
+
+type Invoice struct {
+	ExternalID  string
+	ReferenceID string
+	Amount      float64
+	Status      string
+	PaymentUrl  string
+	ExpiredAt   time.Time
+}
+
+type CallbackData struct {
+	ExternalID  string     `json:"externalID"`
+	ReferenceID string     `json:"referenceID"`
+	Amount      float64    `json:"amount"`
+	Status      string     `json:"status"`
+	PaidAt      *time.Time `json:"paidAt"`
+}
+
+type RefundInput struct {
+	// ReferenceID is the idempotency key of the refund, the same reference id is refunded once
+	ReferenceID string
+	ExternalID  string
+	Amount      float64
+	Reason      string
+}
+
+type RefundData struct {
+	ExternalID string
+	RefundID   string
+	Amount     float64
+	Status     string
+}
@@ -15,6 +15,8 @@ type Repo interface {
 type Command interface {
 	Login(ctx context.Context, input model.UserLoginInput) (*model.UserLoginOutput, error)
 	LoginByGoogle(ctx context.Context, input model.UserLoginByGooleInput) (*model.UserLoginOutput, error)
+	// SelectPond issue the new token with the selected pond, the access of the pond is checked by the caller
+	SelectPond(ctx context.Context, pondID uuid.UUID) (*model.UserLoginOutput, error)
 
 	CreateUser(ctx context.Context, input model.CreateUserInput) (*uuid.UUID, error)
 	UpdateUser(ctx context.Context, input model.UpdateUserInput) (*uuid.UUID, error)
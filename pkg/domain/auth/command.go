@@ -219,6 +219,49 @@ func (c *command) LoginByGoogle(ctx context.Context, input model.UserLoginByGool
 	}, nil
 }
 
+// SelectPond implements Command.
+// the selected pond is saved to the user, so the next login use the last selected pond
+func (c *command) SelectPond(ctx context.Context, pondID uuid.UUID) (*model.UserLoginOutput, error) {
+	var (
+		userID, _  = ctxutil.GetUserID(ctx)
+		roleID, _  = ctxutil.GetRoleID(ctx)
+		appType, _ = ctxutil.GetUserAppType(ctx)
+		today      = time.Now()
+	)
+
+	if pondID == uuid.Nil {
+		return nil, errorauth.ErrSelectPond.AttacthDetail(map[string]any{"pondID": "empty"})
+	}
+
+	err := c.dbTxn.Model(&model.User{}).
+		Where("deleted_at IS NULL and id = ?", userID).
+		Updates(map[string]any{
+			"pond_id":    pondID,
+			"updated_at": today,
+			"updated_by": userID,
+		}).Error
+	if err != nil {
+		return nil, errorauth.ErrUpdateUser.AttacthDetail(map[string]any{"error": err})
+	}
+
+	token, err := c.tokenMaker.CreateToken(&token.Payload{
+		UserID:    userID,
+		PondID:    pondID,
+		UserRole:  roleID,
+		AppType:   appType,
+		IssuedAt:  today,
+		ExpiredAt: today.AddDate(1, 0, 0),
+	})
+	if err != nil {
+		return nil, errorauth.ErrTokenError.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return &model.UserLoginOutput{
+		ApplicationType: appType,
+		Token:           token,
+	}, nil
+}
+
 func (c *command) UpdateUserStatusAndPondID(ctx context.Context, input uuid.UUID) (*uuid.UUID, error) {
 	var (
 		userID, _ = ctxutil.GetUserID(ctx)
@@ -93,4 +93,9 @@ var (
 		Code:    "FailedUpdateUser",
 		Message: "failed update user",
 	}
+
+	ErrSelectPond = werror.Error{
+		Code:    "FailedSelectPond",
+		Message: "failed select pond",
+	}
 )
@@ -3,7 +3,9 @@ package budidaya
 import (
 	"context"
 
+	"github.com/e-fish/api/pkg/common/helper/geo"
 	"github.com/e-fish/api/pkg/domain/budidaya/model"
+	status "github.com/e-fish/api/pkg/domain/pond/model"
 	"github.com/google/uuid"
 )
 
@@ -17,10 +19,25 @@ type Command interface {
 	UpdateStatusBudidaya(ctx context.Context, input model.UpdateBudidayaStatusInput) (*uuid.UUID, error)
 	UpdateStatusBudidayaWithListPricelist(ctx context.Context, input model.UpdateBudidayaWithPricelist) (*uuid.UUID, error)
 	UpdateBudidayaSoldQty(ctx context.Context, input model.UpdateBudidayaSoldQty) (*uuid.UUID, error)
+	UpdateBudidayaReservedQty(ctx context.Context, input model.UpdateBudidayaReservedQty) (*uuid.UUID, error)
 	CreateMultiplePricelistBudidaya(ctx context.Context, input model.CreateMultiplePriceListInput) ([]*uuid.UUID, error)
+	// ReadBudidayaActiveByLockedPool lock the pool and read the active budidaya of the pool in the transaction,
+	// so the budidaya can't be created in the pool until the transaction is ended
+	ReadBudidayaActiveByLockedPool(ctx context.Context, poolID uuid.UUID) (*model.BudidayaOutput, error)
 
 	CreateFishSpecies(ctx context.Context, input model.CreateFishSpeciesInput) (*uuid.UUID, error)
 
+	CreateFeedingLog(ctx context.Context, input model.CreateFeedingLogInput) (*uuid.UUID, error)
+	CreateMortalityLog(ctx context.Context, input model.CreateMortalityLogInput) (*uuid.UUID, error)
+	CreateSamplingLog(ctx context.Context, input model.CreateSamplingLogInput) (*uuid.UUID, error)
+
+	CreateMultipleWaterQuality(ctx context.Context, input model.CreateMultipleWaterQualityInput) (*model.CreateWaterQualityOutput, error)
+	UpdateWaterQualityRange(ctx context.Context, input model.UpdateWaterQualityRangeInput) (*uuid.UUID, error)
+
+	CreateHarvest(ctx context.Context, input model.CreateHarvestInput) (*uuid.UUID, error)
+
+	UpdateGrowthCurve(ctx context.Context, input model.UpdateGrowthCurveInput) (*uuid.UUID, error)
+
 	Rollback(ctx context.Context) error
 	Commit(ctx context.Context) error
 }
@@ -32,14 +49,31 @@ type Query interface {
 	ReadBudidayaByUserAdmin(ctx context.Context, input model.GetBudidayaInput) ([]*model.BudidayaOutput, error)
 	ReadBudidayaByUserSeller(ctx context.Context) ([]*model.BudidayaOutput, error)
 	ReadBudidayaCodeActive(ctx context.Context) (*string, error)
-	ReadBudidayaNeaerest(ctx context.Context) ([]*model.BudidayaOutput, error)
+	ReadBudidayaNeaerest(ctx context.Context, nearby geo.Nearby) ([]*model.BudidayaOutput, error)
 
 	ReadBudidayaByID(ctx context.Context, id uuid.UUID) (*model.BudidayaOutput, error)
+	ReadPoolByID(ctx context.Context, id uuid.UUID) (*status.PoolOutput, error)
 
 	ReadAllDataFishSpecies(ctx context.Context) ([]*model.FishSpeciesOutput, error)
+	ReadFishSpeciesByID(ctx context.Context, id uuid.UUID) (*model.FishSpeciesOutput, error)
+
+	ReadJournalByBudidayaID(ctx context.Context, budidayaID uuid.UUID) (*model.JournalOutput, error)
+	ReadLastSamplingLog(ctx context.Context, budidayaID uuid.UUID) (*model.SamplingLogOutput, error)
+
+	ReadWaterQualityRangeByFishSpeciesID(ctx context.Context, fishSpeciesID uuid.UUID) ([]*model.WaterQualityRangeOutput, error)
+	ReadWaterQualityByPoolID(ctx context.Context, input model.ReadWaterQualityInput) ([]*model.WaterQualityOutput, error)
+
+	ReadHarvestByBudidayaID(ctx context.Context, budidayaID uuid.UUID) (*model.HarvestOutput, error)
+	ReadHarvestPrediction(ctx context.Context, budidayaID uuid.UUID) (*model.HarvestPredictionOutput, error)
 
 	ReadPriceListBudidayaByBiggerThanLimitAndBudidayaID(ctx context.Context, input model.ReadPricelistBudidayaInput) (*model.PriceList, error)
 	ReadPriceListBudidayaBySmallerThanLimitAndBudidayaID(ctx context.Context, input model.ReadPricelistBudidayaInput) (*model.PriceList, error)
+	ReadPricelistByID(ctx context.Context, id uuid.UUID) (*model.PriceList, error)
+	ReadPricelistHistoryByBudidayaID(ctx context.Context, budidayaID uuid.UUID) ([]*model.PriceListOutput, error)
+	ReadPricelistLatestByBudidayaID(ctx context.Context, budidayaID uuid.UUID) ([]*model.PriceList, error)
+	ReadQuote(ctx context.Context, input model.ReadPricelistBudidayaInput) (*model.QuoteOutput, error)
+	ReadMarketplace(ctx context.Context, input model.MarketplaceInput) (*model.MarketplaceOutput, error)
 
 	lock() Query
+	checkPondSeller(ctx context.Context, action string) error
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/e-fish/api/pkg/common/helper/logger"
 	"github.com/e-fish/api/pkg/common/helper/werror"
 	"github.com/e-fish/api/pkg/common/infra/orm"
+	usertype "github.com/e-fish/api/pkg/domain/auth/model"
 	errorbudidaya "github.com/e-fish/api/pkg/domain/budidaya/error-budidaya"
 	"github.com/e-fish/api/pkg/domain/budidaya/model"
 	"github.com/e-fish/api/pkg/domain/pond"
@@ -23,7 +24,7 @@ func newCommand(ctx context.Context, db *gorm.DB, pondRrepo pond.Repo) Command {
 
 	return &command{
 		dbTxn:     dbTxn.WithContext(ctx),
-		query:     newQuery(dbTxn),
+		query:     newQuery(dbTxn, pondRrepo),
 		pondQuery: pondRrepo.NewQuery(),
 	}
 }
@@ -34,6 +35,16 @@ type command struct {
 	pondQuery pond.Query
 }
 
+// ReadBudidayaActiveByLockedPool implements Command.
+func (c *command) ReadBudidayaActiveByLockedPool(ctx context.Context, poolID uuid.UUID) (*model.BudidayaOutput, error) {
+	_, err := c.query.lock().ReadPoolByID(ctx, poolID)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.query.ReadBudidayaActiveByPoolID(ctx, poolID)
+}
+
 // UpdateStatusBudidayaWithListPricelist implements Command.
 func (c *command) UpdateStatusBudidayaWithListPricelist(ctx context.Context, input model.UpdateBudidayaWithPricelist) (*uuid.UUID, error) {
 	var (
@@ -45,6 +56,11 @@ func (c *command) UpdateStatusBudidayaWithListPricelist(ctx context.Context, inp
 		return nil, err
 	}
 
+	_, err = c.readBudidayaSeller(ctx, input.BudidayaID, status.TEAM_MANAGE_BUDIDAYA)
+	if err != nil {
+		return nil, err
+	}
+
 	updatedBudidaya := input.ToBudidaya(userID)
 
 	err = c.dbTxn.Updates(&updatedBudidaya).Error
@@ -53,14 +69,64 @@ func (c *command) UpdateStatusBudidayaWithListPricelist(ctx context.Context, inp
 	}
 
 	pricelist := model.UpdatePricelistInputToPricelist(input.Pricelist, userID)
-	err = c.dbTxn.Save(&pricelist).Error
+
+	latest, err := c.query.ReadPricelistLatestByBudidayaID(ctx, input.BudidayaID)
 	if err != nil {
-		return nil, errorbudidaya.ErrFailedUpdateBudidaya.AttacthDetail(map[string]any{"error": err, "flag": "save pricelist"})
+		return nil, err
+	}
+
+	err = model.ValidatePricelistTier(model.MergePricelistTier(latest, pricelist))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range pricelist {
+		err = c.replacePricelist(ctx, v)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return &input.BudidayaID, nil
 }
 
+// replacePricelist close the previous version at the effective date of the new version,
+// the previous version is never updated so the order keep the price of the version
+func (c *command) replacePricelist(ctx context.Context, newVersion *model.PriceList) error {
+	previous, err := c.query.lock().ReadPricelistByID(ctx, *newVersion.PreviousID)
+	if err != nil {
+		return err
+	}
+
+	if previous.BudidayaID != newVersion.BudidayaID {
+		return errorbudidaya.ErrFoundPricelist.AttacthDetail(map[string]any{"id": previous.ID, "budidayaID": newVersion.BudidayaID})
+	}
+
+	if previous.EffectiveTo != nil {
+		return errorbudidaya.ErrPricelistReplaced.AttacthDetail(map[string]any{"id": previous.ID, "effectiveTo": previous.EffectiveTo})
+	}
+
+	if previous.EffectiveFrom != nil && newVersion.EffectiveFrom.Before(*previous.EffectiveFrom) {
+		return errorbudidaya.ErrValidateInputPriceList.AttacthDetail(map[string]any{"effectiveFrom": "must be after the effective date of the previous version"})
+	}
+
+	err = c.dbTxn.Model(&model.PriceList{}).Where("id = ?", previous.ID).Updates(map[string]any{
+		"effective_to": newVersion.EffectiveFrom,
+		"updated_at":   newVersion.CreatedAt,
+		"updated_by":   newVersion.CreatedBy,
+	}).Error
+	if err != nil {
+		return errorbudidaya.ErrFailedUpdateBudidaya.AttacthDetail(map[string]any{"error": err, "flag": "close pricelist"})
+	}
+
+	err = c.dbTxn.Create(newVersion).Error
+	if err != nil {
+		return errorbudidaya.ErrFailedUpdateBudidaya.AttacthDetail(map[string]any{"error": err, "flag": "create pricelist"})
+	}
+
+	return nil
+}
+
 // CreateBudidaya implements Command.
 func (c *command) CreateBudidaya(ctx context.Context, input model.CreateBudidayaInput) (*uuid.UUID, error) {
 	var (
@@ -68,6 +134,11 @@ func (c *command) CreateBudidaya(ctx context.Context, input model.CreateBudidaya
 		pondID, _ = ctxutil.GetPondID(ctx)
 	)
 
+	err := c.query.checkPondSeller(ctx, status.TEAM_MANAGE_BUDIDAYA)
+	if err != nil {
+		return nil, err
+	}
+
 	data, err := c.pondQuery.GetPondByID(ctx, pondID)
 	if err != nil {
 		return nil, err
@@ -82,6 +153,12 @@ func (c *command) CreateBudidaya(ctx context.Context, input model.CreateBudidaya
 		return nil, err
 	}
 
+	// the pool is locked, so the pool can't be archived while the budidaya is created
+	pool, err := c.query.lock().ReadPoolByID(ctx, input.PoolID)
+	if err != nil {
+		return nil, err
+	}
+
 	logger.InfoWithContext(ctx, "###find existing budidaya by pool id for validate budidaya not exist")
 	exist, err := c.query.ReadBudidayaActiveByPoolID(ctx, input.PoolID)
 	if !errorbudidaya.ErrFoundBudidaya.Is(err) {
@@ -92,6 +169,23 @@ func (c *command) CreateBudidaya(ctx context.Context, input model.CreateBudidaya
 		return nil, errorbudidaya.ErrFailedCreateBudidayaExist.AttacthDetail(map[string]any{"pool": exist.PoolID})
 	}
 
+	if pool.PondID != pondID {
+		return nil, errorbudidaya.ErrFailedCreateBudidaya.AttacthDetail(map[string]any{"poolID": input.PoolID, "error": "the pool is not in the pond"})
+	}
+	if pool.ArchivedAt != nil {
+		return nil, errorbudidaya.ErrFailedCreateBudidaya.AttacthDetail(map[string]any{"poolID": input.PoolID, "error": "the pool is archived"})
+	}
+
+	species, err := c.query.ReadFishSpeciesByID(ctx, input.FishSpeciesID)
+	if err != nil {
+		return nil, err
+	}
+
+	density := model.StockingDensity(input.SeedCount, pool.Long, pool.Wide)
+	if species.MaxDensity > 0 && density > species.MaxDensity {
+		return nil, errorbudidaya.ErrStockingDensity.AttacthDetail(map[string]any{"density": density, "maxDensity": species.MaxDensity})
+	}
+
 	if input.Code == "" {
 		code, err := c.query.ReadBudidayaCodeActive(ctx)
 		if err != nil {
@@ -110,6 +204,7 @@ func (c *command) CreateBudidaya(ctx context.Context, input model.CreateBudidaya
 	}
 
 	newBudidaya := input.ToBudidaya(userID, pondID)
+	newBudidaya.StockingDensity = density
 
 	err = c.dbTxn.Create(&newBudidaya).Error
 	if err != nil {
@@ -152,21 +247,32 @@ func (c *command) CreateMultiplePricelistBudidaya(ctx context.Context, input mod
 		return nil, err
 	}
 
-	exist, err := c.query.ReadBudidayaByID(ctx, input.BudidayaID)
+	exist, err := c.readBudidayaSeller(ctx, input.BudidayaID, status.TEAM_MANAGE_BUDIDAYA)
 	if err != nil {
 		return nil, err
 	}
 
-	if exist == nil {
-		return nil, errorbudidaya.ErrFoundBudidaya
-	}
-
 	if input.EstDate.Before(exist.DateOfSeed) {
 		return nil, errorbudidaya.ErrFailedUpdateBudidayaEstDate.AttacthDetail(map[string]any{"Sowing-period": exist.DateOfSeed, "Harvest-estimate": input.EstDate})
 	}
 
 	newPricelist := input.ToMultiplePriceList(userID)
 
+	latest, err := c.query.ReadPricelistLatestByBudidayaID(ctx, input.BudidayaID)
+	if err != nil {
+		return nil, err
+	}
+
+	tier := []*model.PriceList{}
+	for idx := range newPricelist {
+		tier = append(tier, &newPricelist[idx])
+	}
+
+	err = model.ValidatePricelistTier(model.MergePricelistTier(latest, tier))
+	if err != nil {
+		return nil, err
+	}
+
 	err = c.dbTxn.Create(&newPricelist).Error
 	if err != nil {
 		return nil, errorbudidaya.ErrFailedCreateBudidaya.AttacthDetail(map[string]any{"error": err})
@@ -191,14 +297,30 @@ func (c *command) CreateMultiplePricelistBudidaya(ctx context.Context, input mod
 }
 
 // UpdateStatusBudidaya implements Command.
+// the status is changed by the transition of model.ValidateStatus, END is only set by the harvest
 func (c *command) UpdateStatusBudidaya(ctx context.Context, input model.UpdateBudidayaStatusInput) (*uuid.UUID, error) {
 	var (
 		userID, _ = ctxutil.GetUserID(ctx)
+		actor, _  = ctxutil.GetUserAppType(ctx)
 	)
 
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	exist, err := c.readBudidayaSeller(ctx, input.ID, status.TEAM_MANAGE_BUDIDAYA)
+	if err != nil {
+		return nil, err
+	}
+
+	if !model.CanUpdateStatus(actor, exist.Status, input.Status) {
+		return nil, errorbudidaya.ErrInvalidStatusBudidaya.AttacthDetail(map[string]any{"from": exist.Status, "to": input.Status, "actor": actor})
+	}
+
 	newStatus := input.ToBudidaya(userID)
 
-	err := c.dbTxn.Updates(&newStatus).Error
+	err = c.dbTxn.Updates(&newStatus).Error
 	if err != nil {
 		return nil, errorbudidaya.ErrFailedUpdateBudidaya.AttacthDetail(map[string]any{"error": err})
 	}
@@ -238,25 +360,24 @@ func (c *command) UpdateBudidayaSoldQty(ctx context.Context, input model.UpdateB
 		return nil, errorbudidaya.ErrFoundBudidaya.AttacthDetail(map[string]any{"error": "budidaya empty"})
 	}
 
-	exist.Sold = exist.Sold + input.SoldQty
 	if input.IsCancel {
 		exist.Sold = exist.Sold - input.SoldQty
-
+	} else {
+		exist.Sold = exist.Sold + input.SoldQty
 	}
 
-	if !input.IsCancel && (input.SoldQty > exist.Stock) {
+	if !input.IsCancel && (input.SoldQty > exist.Available) {
 		return nil, werror.Error{
 			Code:    "FailedUpdateOrder",
 			Message: "Order Estimate Exceeded Capacity",
 		}
 	}
 
-	err = c.dbTxn.Where("deleted_at IS NULL AND id = ?", input.ID).Updates(&model.Budidaya{
-		Sold: exist.Sold,
-		OrmModel: orm.OrmModel{
-			UpdatedAt: &today,
-			UpdatedBy: &userID,
-		},
+	// use map, the sold qty can be 0
+	err = c.dbTxn.Model(&model.Budidaya{}).Where("deleted_at IS NULL AND id = ?", input.ID).Updates(map[string]any{
+		"sold":       exist.Sold,
+		"updated_at": &today,
+		"updated_by": &userID,
 	}).Error
 	if err != nil {
 		return nil, errorbudidaya.ErrFailedUpdateBudidaya.AttacthDetail(map[string]any{"error": err})
@@ -264,3 +385,417 @@ func (c *command) UpdateBudidayaSoldQty(ctx context.Context, input model.UpdateB
 
 	return &input.ID, nil
 }
+
+// UpdateBudidayaReservedQty implements Command.
+func (c *command) UpdateBudidayaReservedQty(ctx context.Context, input model.UpdateBudidayaReservedQty) (*uuid.UUID, error) {
+	var (
+		userID, _ = ctxutil.GetUserID(ctx)
+		today     = time.Now()
+	)
+
+	exist, err := c.query.lock().ReadBudidayaByID(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch input.Action {
+	case model.RESERVE:
+		if input.Qty > exist.Available {
+			return nil, werror.Error{
+				Code:    "FailedUpdateOrder",
+				Message: "Order Estimate Exceeded Capacity",
+			}
+		}
+		exist.Reserved = exist.Reserved + input.Qty
+	case model.RELEASE:
+		exist.Reserved = exist.Reserved - input.Qty
+	case model.CONFIRM:
+		exist.Reserved = exist.Reserved - input.Qty
+		exist.Sold = exist.Sold + input.Qty
+	default:
+		return nil, errorbudidaya.ErrUpdateReservedQty.AttacthDetail(map[string]any{"action": input.Action})
+	}
+
+	if exist.Reserved < 0 {
+		return nil, errorbudidaya.ErrUpdateReservedQty.AttacthDetail(map[string]any{"reserved": exist.Reserved})
+	}
+
+	// use map, the reserved qty can be 0
+	err = c.dbTxn.Model(&model.Budidaya{}).Where("deleted_at IS NULL AND id = ?", input.ID).Updates(map[string]any{
+		"reserved":   exist.Reserved,
+		"sold":       exist.Sold,
+		"updated_at": &today,
+		"updated_by": &userID,
+	}).Error
+	if err != nil {
+		return nil, errorbudidaya.ErrFailedUpdateBudidaya.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return &input.ID, nil
+}
+
+// readBudidayaSeller lock the budidaya that will be changed,
+// the seller can only change the budidaya of his pond with the permission of the action in the team
+func (c *command) readBudidayaSeller(ctx context.Context, id uuid.UUID, action string) (*model.BudidayaOutput, error) {
+	var (
+		pondID, _  = ctxutil.GetPondID(ctx)
+		appType, _ = ctxutil.GetUserAppType(ctx)
+	)
+
+	err := c.query.checkPondSeller(ctx, action)
+	if err != nil {
+		return nil, err
+	}
+
+	exist, err := c.query.lock().ReadBudidayaByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if appType == usertype.SELLER && exist.PondID != pondID {
+		return nil, errorbudidaya.ErrAccessBudidaya.AttacthDetail(map[string]any{"id": id})
+	}
+
+	return exist, nil
+}
+
+// readBudidayaJournal lock the budidaya that will be written in the journal while it is not ended,
+// the seller can only write the journal of his pond
+func (c *command) readBudidayaJournal(ctx context.Context, id uuid.UUID, action string) (*model.BudidayaOutput, error) {
+	exist, err := c.readBudidayaSeller(ctx, id, action)
+	if err != nil {
+		return nil, err
+	}
+
+	if model.IsClosedStatus(exist.Status) {
+		return nil, errorbudidaya.ErrBudidayaNotActive.AttacthDetail(map[string]any{"id": id, "status": exist.Status})
+	}
+
+	return exist, nil
+}
+
+// CreateFeedingLog implements Command.
+func (c *command) CreateFeedingLog(ctx context.Context, input model.CreateFeedingLogInput) (*uuid.UUID, error) {
+	var (
+		userID, _ = ctxutil.GetUserID(ctx)
+		today     = time.Now()
+	)
+
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	exist, err := c.readBudidayaJournal(ctx, input.BudidayaID, status.TEAM_WRITE_LOG)
+	if err != nil {
+		return nil, err
+	}
+
+	newFeedingLog := input.ToFeedingLog(userID)
+	err = c.dbTxn.Create(&newFeedingLog).Error
+	if err != nil {
+		return nil, errorbudidaya.ErrCreateJournal.AttacthDetail(map[string]any{"error": err})
+	}
+
+	err = c.dbTxn.Model(&model.Budidaya{}).Where("deleted_at IS NULL AND id = ?", input.BudidayaID).Updates(map[string]any{
+		"total_feed": exist.TotalFeed + input.Qty,
+		"updated_at": &today,
+		"updated_by": &userID,
+	}).Error
+	if err != nil {
+		return nil, errorbudidaya.ErrFailedUpdateBudidaya.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return &newFeedingLog.ID, nil
+}
+
+// CreateMortalityLog implements Command.
+func (c *command) CreateMortalityLog(ctx context.Context, input model.CreateMortalityLogInput) (*uuid.UUID, error) {
+	var (
+		userID, _ = ctxutil.GetUserID(ctx)
+		today     = time.Now()
+	)
+
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	exist, err := c.readBudidayaJournal(ctx, input.BudidayaID, status.TEAM_WRITE_LOG)
+	if err != nil {
+		return nil, err
+	}
+
+	totalMortality := exist.TotalMortality + input.Count
+	if exist.SeedCount > 0 && totalMortality > exist.SeedCount {
+		return nil, errorbudidaya.ErrValidateInputJournal.AttacthDetail(map[string]any{"count": "more than the seed count", "seedCount": exist.SeedCount, "totalMortality": totalMortality})
+	}
+
+	newMortalityLog := input.ToMortalityLog(userID)
+	err = c.dbTxn.Create(&newMortalityLog).Error
+	if err != nil {
+		return nil, errorbudidaya.ErrCreateJournal.AttacthDetail(map[string]any{"error": err})
+	}
+
+	err = c.dbTxn.Model(&model.Budidaya{}).Where("deleted_at IS NULL AND id = ?", input.BudidayaID).Updates(map[string]any{
+		"total_mortality": totalMortality,
+		"updated_at":      &today,
+		"updated_by":      &userID,
+	}).Error
+	if err != nil {
+		return nil, errorbudidaya.ErrFailedUpdateBudidaya.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return &newMortalityLog.ID, nil
+}
+
+// CreateSamplingLog implements Command.
+// the average weight of the budidaya always follow the latest sampling by date
+func (c *command) CreateSamplingLog(ctx context.Context, input model.CreateSamplingLogInput) (*uuid.UUID, error) {
+	var (
+		userID, _ = ctxutil.GetUserID(ctx)
+		today     = time.Now()
+	)
+
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = c.readBudidayaJournal(ctx, input.BudidayaID, status.TEAM_WRITE_LOG)
+	if err != nil {
+		return nil, err
+	}
+
+	newSamplingLog := input.ToSamplingLog(userID)
+	err = c.dbTxn.Create(&newSamplingLog).Error
+	if err != nil {
+		return nil, errorbudidaya.ErrCreateJournal.AttacthDetail(map[string]any{"error": err})
+	}
+
+	latest, err := c.query.ReadLastSamplingLog(ctx, input.BudidayaID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.dbTxn.Model(&model.Budidaya{}).Where("deleted_at IS NULL AND id = ?", input.BudidayaID).Updates(map[string]any{
+		"average_weight": latest.AverageWeight,
+		"updated_at":     &today,
+		"updated_by":     &userID,
+	}).Error
+	if err != nil {
+		return nil, errorbudidaya.ErrFailedUpdateBudidaya.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return &newSamplingLog.ID, nil
+}
+
+// CreateMultipleWaterQuality implements Command.
+// the reading is checked with the safe range of the fish species in the active budidaya of the pool,
+// the pool without active budidaya is only recorded
+func (c *command) CreateMultipleWaterQuality(ctx context.Context, input model.CreateMultipleWaterQualityInput) (*model.CreateWaterQualityOutput, error) {
+	var (
+		userID, _ = ctxutil.GetUserID(ctx)
+		pondID, _ = ctxutil.GetPondID(ctx)
+		listPool  = map[uuid.UUID]bool{}
+		budidaya  = map[uuid.UUID]*model.BudidayaOutput{}
+		ranges    = map[uuid.UUID][]*model.WaterQualityRangeOutput{}
+	)
+
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.query.checkPondSeller(ctx, status.TEAM_WRITE_LOG)
+	if err != nil {
+		return nil, err
+	}
+
+	pond, err := c.pondQuery.GetPondByID(ctx, pondID)
+	if err != nil {
+		return nil, err
+	}
+
+	pools, err := c.pondQuery.GetListPool(ctx, pondID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range pools {
+		listPool[v.ID] = true
+	}
+
+	result := model.CreateWaterQualityOutput{
+		ListID: []uuid.UUID{},
+		Alerts: []*model.WaterQualityAlertOutput{},
+		UserID: pond.UserID,
+	}
+
+	for _, v := range input.Input {
+		if !listPool[v.PoolID] {
+			return nil, errorbudidaya.ErrFoundPool.AttacthDetail(map[string]any{"poolID": v.PoolID})
+		}
+
+		if _, ok := budidaya[v.PoolID]; !ok {
+			exist, err := c.query.ReadBudidayaActiveByPoolID(ctx, v.PoolID)
+			if err != nil && !errorbudidaya.ErrFoundBudidaya.Is(err) {
+				return nil, err
+			}
+
+			budidaya[v.PoolID] = exist
+			if exist != nil {
+				ranges[v.PoolID], err = c.query.ReadWaterQualityRangeByFishSpeciesID(ctx, exist.FishSpeciesID)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		var budidayaID *uuid.UUID
+		if exist := budidaya[v.PoolID]; exist != nil {
+			budidayaID = &exist.ID
+		}
+
+		reading := v.ToWaterQuality(userID, pondID, budidayaID)
+		err = c.dbTxn.Create(&reading).Error
+		if err != nil {
+			return nil, errorbudidaya.ErrCreateWaterQuality.AttacthDetail(map[string]any{"error": err})
+		}
+
+		alerts := model.GenerateWaterQualityAlert(reading, ranges[v.PoolID], userID)
+		if len(alerts) > 0 {
+			err = c.dbTxn.Create(&alerts).Error
+			if err != nil {
+				return nil, errorbudidaya.ErrCreateWaterQuality.AttacthDetail(map[string]any{"error": err, "flag": "create alert"})
+			}
+		}
+
+		result.ListID = append(result.ListID, reading.ID)
+		for _, alert := range alerts {
+			result.Alerts = append(result.Alerts, alert.ToWaterQualityAlertOutput())
+		}
+	}
+
+	return &result, nil
+}
+
+// UpdateWaterQualityRange implements Command.
+// the old range of the fish species is replaced by the new range
+func (c *command) UpdateWaterQualityRange(ctx context.Context, input model.UpdateWaterQualityRangeInput) (*uuid.UUID, error) {
+	var (
+		userID, _  = ctxutil.GetUserID(ctx)
+		appType, _ = ctxutil.GetUserAppType(ctx)
+		today      = time.Now()
+	)
+
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	species, err := c.query.ReadFishSpeciesByID(ctx, input.FishSpeciesID)
+	if err != nil {
+		return nil, err
+	}
+
+	if appType != usertype.ADMIN && species.CreatedBy != userID {
+		return nil, errorbudidaya.ErrAccessFishSpecies.AttacthDetail(map[string]any{"fishSpeciesID": input.FishSpeciesID})
+	}
+
+	err = c.dbTxn.Model(&model.WaterQualityRange{}).Where("deleted_at IS NULL and fish_species_id = ?", input.FishSpeciesID).Updates(map[string]any{
+		"deleted_at": &today,
+		"deleted_by": &userID,
+	}).Error
+	if err != nil {
+		return nil, errorbudidaya.ErrUpdateWaterQualityRange.AttacthDetail(map[string]any{"error": err})
+	}
+
+	newRange := input.ToWaterQualityRange(userID)
+	if len(newRange) > 0 {
+		err = c.dbTxn.Create(&newRange).Error
+		if err != nil {
+			return nil, errorbudidaya.ErrUpdateWaterQualityRange.AttacthDetail(map[string]any{"error": err})
+		}
+	}
+
+	return &input.FishSpeciesID, nil
+}
+
+// CreateHarvest implements Command.
+// the harvest close the budidaya to END, so the pool can be used by the next budidaya,
+// the active order of the budidaya must be reconciled before the harvest
+func (c *command) CreateHarvest(ctx context.Context, input model.CreateHarvestInput) (*uuid.UUID, error) {
+	var (
+		userID, _ = ctxutil.GetUserID(ctx)
+		today     = time.Now()
+	)
+
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	exist, err := c.readBudidayaJournal(ctx, input.BudidayaID, status.TEAM_MANAGE_BUDIDAYA)
+	if err != nil {
+		return nil, err
+	}
+
+	if !model.ValidateStatus[exist.Status][model.END] {
+		return nil, errorbudidaya.ErrInvalidStatusBudidaya.AttacthDetail(map[string]any{"from": exist.Status, "to": model.END})
+	}
+
+	if exist.Reserved > 0 {
+		return nil, errorbudidaya.ErrHarvestOrderActive.AttacthDetail(map[string]any{"reserved": exist.Reserved})
+	}
+
+	if input.ActualTonase < float64(exist.Sold) {
+		return nil, errorbudidaya.ErrHarvestShortage.AttacthDetail(map[string]any{"actualTonase": input.ActualTonase, "sold": exist.Sold})
+	}
+
+	newHarvest := input.ToHarvest(userID, *exist)
+	err = c.dbTxn.Create(&newHarvest).Error
+	if err != nil {
+		return nil, errorbudidaya.ErrCreateHarvest.AttacthDetail(map[string]any{"error": err})
+	}
+
+	err = c.dbTxn.Model(&model.Budidaya{}).Where("deleted_at IS NULL AND id = ?", input.BudidayaID).Updates(map[string]any{
+		"status":     model.END,
+		"updated_at": &today,
+		"updated_by": &userID,
+	}).Error
+	if err != nil {
+		return nil, errorbudidaya.ErrFailedUpdateBudidaya.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return &newHarvest.ID, nil
+}
+
+// UpdateGrowthCurve implements Command.
+func (c *command) UpdateGrowthCurve(ctx context.Context, input model.UpdateGrowthCurveInput) (*uuid.UUID, error) {
+	var (
+		userID, _  = ctxutil.GetUserID(ctx)
+		appType, _ = ctxutil.GetUserAppType(ctx)
+	)
+
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	species, err := c.query.ReadFishSpeciesByID(ctx, input.FishSpeciesID)
+	if err != nil {
+		return nil, err
+	}
+
+	if appType != usertype.ADMIN && species.CreatedBy != userID {
+		return nil, errorbudidaya.ErrAccessFishSpecies.AttacthDetail(map[string]any{"fishSpeciesID": input.FishSpeciesID})
+	}
+
+	err = c.dbTxn.Model(&model.FishSpecies{}).Where("deleted_at IS NULL and id = ?", input.FishSpeciesID).Updates(input.ToMap(userID)).Error
+	if err != nil {
+		return nil, errorbudidaya.ErrUpdateGrowthCurve.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return &input.FishSpeciesID, nil
+}
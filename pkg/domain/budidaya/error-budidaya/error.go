@@ -96,4 +96,159 @@ var (
 		Code:    "FailedReadBudidayaCodeExist",
 		Message: "failed read data budidaya",
 	}
+
+	ErrUpdateReservedQty = werror.Error{
+		Code:    "FailedUpdateReservedQty",
+		Message: "failed update reserved qty",
+	}
+
+	ErrValidateInputJournal = werror.Error{
+		Code:    "ValidatedFailedInputJournal",
+		Message: "invalid journal input",
+	}
+
+	ErrAccessBudidaya = werror.Error{
+		Code:    "FailedAccessBudidaya",
+		Message: "budidaya is not owned by the pond",
+	}
+
+	ErrBudidayaNotActive = werror.Error{
+		Code:    "FailedBudidayaNotActive",
+		Message: "budidaya is already ended",
+	}
+
+	ErrCreateJournal = werror.Error{
+		Code:    "FailedCreateJournal",
+		Message: "failed create journal budidaya",
+	}
+
+	ErrReadJournalData = werror.Error{
+		Code:    "FailedReadJournalData",
+		Message: "failed read journal data",
+	}
+
+	ErrValidateInputWaterQuality = werror.Error{
+		Code:    "ValidatedFailedInputWaterQuality",
+		Message: "invalid water quality input",
+	}
+
+	ErrFoundPool = werror.Error{
+		Code:    "FailedFoundPool",
+		Message: "pool not found in the pond",
+	}
+
+	ErrCreateWaterQuality = werror.Error{
+		Code:    "FailedCreateWaterQuality",
+		Message: "failed create water quality",
+	}
+
+	ErrReadWaterQualityData = werror.Error{
+		Code:    "FailedReadWaterQualityData",
+		Message: "failed read water quality data",
+	}
+
+	ErrFoundFishSpecies = werror.Error{
+		Code:    "FailedFoundFishSpecies",
+		Message: "fish species not found",
+	}
+
+	ErrAccessFishSpecies = werror.Error{
+		Code:    "FailedAccessFishSpecies",
+		Message: "fish species is not created by the user",
+	}
+
+	ErrUpdateWaterQualityRange = werror.Error{
+		Code:    "FailedUpdateWaterQualityRange",
+		Message: "failed update water quality range",
+	}
+
+	ErrValidateInputHarvest = werror.Error{
+		Code:    "ValidatedFailedInputHarvest",
+		Message: "invalid harvest input",
+	}
+
+	ErrHarvestOrderActive = werror.Error{
+		Code:    "FailedHarvestOrderActive",
+		Message: "the budidaya still has reserved order",
+	}
+
+	ErrHarvestShortage = werror.Error{
+		Code:    "FailedHarvestShortage",
+		Message: "the actual tonase is less than the sold qty",
+	}
+
+	ErrCreateHarvest = werror.Error{
+		Code:    "FailedCreateHarvest",
+		Message: "failed create harvest",
+	}
+
+	ErrFoundHarvest = werror.Error{
+		Code:    "FailedFoundHarvest",
+		Message: "harvest not found",
+	}
+
+	ErrInvalidStatusBudidaya = werror.Error{
+		Code:    "InvalidStatusBudidaya",
+		Message: "the status of the budidaya can't be changed",
+	}
+
+	ErrValidateInputGrowthCurve = werror.Error{
+		Code:    "ValidatedFailedInputGrowthCurve",
+		Message: "invalid growth curve input",
+	}
+
+	ErrUpdateGrowthCurve = werror.Error{
+		Code:    "FailedUpdateGrowthCurve",
+		Message: "failed update growth curve",
+	}
+
+	ErrGrowthCurveEmpty = werror.Error{
+		Code:    "FailedGrowthCurveEmpty",
+		Message: "the fish species has no growth curve",
+	}
+
+	ErrPredictHarvest = werror.Error{
+		Code:    "FailedPredictHarvest",
+		Message: "failed predict the harvest",
+	}
+
+	ErrStockingDensity = werror.Error{
+		Code:    "FailedStockingDensity",
+		Message: "the stocking density is more than the maximum density of the fish species",
+	}
+
+	ErrPricelistReplaced = werror.Error{
+		Code:    "FailedPricelistReplaced",
+		Message: "the pricelist is already replaced by the newer version",
+	}
+
+	ErrValidatePricelistTier = werror.Error{
+		Code:    "ValidatedFailedPricelistTier",
+		Message: "invalid tier of the pricelist",
+	}
+
+	ErrValidateInputQuote = werror.Error{
+		Code:    "ValidatedFailedInputQuote",
+		Message: "invalid quote input",
+	}
+
+	ErrQuoteStockNotEnough = werror.Error{
+		Code:    "FailedQuoteStockNotEnough",
+		Message: "the stock of the budidaya is not enough",
+	}
+
+	ErrValidateMarketplaceInput = werror.Error{
+		Code:    "ValidatedFailedMarketplaceInput",
+		Message: "invalid marketplace input",
+	}
+
+	ErrAccessWaterQuality = werror.Error{
+		Code:    "FailedAccessWaterQuality",
+		Message: "water quality is not owned by the pond",
+	}
+
+	ErrFoundSamplingLog = werror.Error{
+		Code:    "FailedFoundSamplingLog",
+		Message: "sampling log not found",
+	}
 )
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/e-fish/api/pkg/domain/pond/model"
@@ -24,8 +25,28 @@ type BudidayaOutput struct {
 	Status          string             `json:"status"`
 	PriceList       []*PriceListOutput `gorm:"foreignKey:BudidayaID;references:ID" json:"priceList,omitempty"`
 
-	Sold  int `json:"sold"`
+	Sold      int `json:"sold"`
+	Reserved  int `json:"reserved"`
+	Available int `json:"available"`
+	// Stock is the same as Available, kept for the old client
 	Stock int `json:"stock"`
+
+	// journal of the budidaya, the weight is in gram and the feed is in kg
+	SeedCount  int     `json:"seedCount"`
+	SeedWeight float64 `json:"seedWeight"`
+	// detail of the seed, the age is in day and the size is in cm, the density is fish per m2
+	SeedAge         int     `json:"seedAge"`
+	SeedSize        float64 `json:"seedSize"`
+	SeedSupplier    string  `json:"seedSupplier"`
+	StockingDensity float64 `json:"stockingDensity"`
+	TotalFeed       float64 `json:"totalFeed"`
+	TotalMortality  int     `json:"totalMortality"`
+	AverageWeight   float64 `json:"averageWeight"`
+	SurvivalRate    float64 `gorm:"-" json:"survivalRate"`
+	Biomass         float64 `gorm:"-" json:"biomass"`
+	FCR             float64 `gorm:"-" json:"fcr"`
+	// Distance is the distance in km from the coordinate of the query to the pond
+	Distance *float64 `gorm:"-" json:"distance,omitempty"`
 }
 
 func (p *BudidayaOutput) TableName() string {
@@ -33,16 +54,43 @@ func (p *BudidayaOutput) TableName() string {
 }
 
 func (p *BudidayaOutput) AfterFind(db *gorm.DB) (err error) {
-	p.Stock = int(p.EstTonase) - p.Sold
+	p.Available = int(p.EstTonase) - p.Sold - p.Reserved
+	p.Stock = p.Available
+	p.CalculateJournal()
 	return
 }
 
+// CalculateJournal set the survival rate, the biomass in kg and the feed conversion ratio,
+// FCR is the total feed divided by the biomass gained since the seed
+func (p *BudidayaOutput) CalculateJournal() {
+	if p.SeedCount < 1 {
+		return
+	}
+
+	alive := p.SeedCount - p.TotalMortality
+	if alive < 0 {
+		alive = 0
+	}
+
+	p.SurvivalRate = float64(alive) / float64(p.SeedCount)
+	p.Biomass = float64(alive) * p.AverageWeight / 1000
+
+	gain := p.Biomass - float64(p.SeedCount)*p.SeedWeight/1000
+	if gain > 0 {
+		p.FCR = p.TotalFeed / gain
+	}
+}
+
 type PriceListOutput struct {
 	ID         uuid.UUID       `gorm:"primaryKey,size:256" json:"id,omitempty"`
 	BudidayaID *uuid.UUID      `json:"budidayaID,omitempty"`
 	Budidaya   *BudidayaOutput `gorm:"foreignKey:BudidayaID;references:ID" json:"budidaya,omitempty"`
 	Limit      int             `json:"limit,omitempty"`
 	Price      int             `json:"price,omitempty"`
+
+	PreviousID    *uuid.UUID `json:"previousID,omitempty"`
+	EffectiveFrom *time.Time `json:"effectiveFrom,omitempty"`
+	EffectiveTo   *time.Time `json:"effectiveTo,omitempty"`
 }
 
 func (p *PriceListOutput) TableName() string {
@@ -50,12 +98,174 @@ func (p *PriceListOutput) TableName() string {
 }
 
 type FishSpeciesOutput struct {
-	ID       uuid.UUID         `gorm:"primaryKey,size:256" json:"id"`
-	Name     string            `json:"name"`
-	Asal     string            `json:"asal"`
-	Budidaya []*BudidayaOutput `gorm:"foreignKey:FishSpeciesID;references:ID" json:"budidaya,omitempty"`
+	ID                uuid.UUID                  `gorm:"primaryKey,size:256" json:"id"`
+	Name              string                     `json:"name"`
+	Asal              string                     `json:"asal"`
+	Budidaya          []*BudidayaOutput          `gorm:"foreignKey:FishSpeciesID;references:ID" json:"budidaya,omitempty"`
+	WaterQualityRange []*WaterQualityRangeOutput `gorm:"foreignKey:FishSpeciesID;references:ID" json:"waterQualityRange,omitempty"`
+	GrowthCurve       `json:"growthCurve"`
+	MaxDensity        float64   `json:"maxDensity"`
+	CreatedBy         uuid.UUID `json:"-"`
 }
 
 func (p *FishSpeciesOutput) TableName() string {
 	return "fish_species"
 }
+
+type FeedingLogOutput struct {
+	ID         uuid.UUID `gorm:"primaryKey,size:256" json:"id"`
+	BudidayaID uuid.UUID `json:"budidayaID"`
+	Date       time.Time `json:"date"`
+	FeedType   string    `json:"feedType"`
+	Qty        float64   `json:"qty"`
+	Note       string    `json:"note"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+func (p *FeedingLogOutput) TableName() string {
+	return "feeding_logs"
+}
+
+type MortalityLogOutput struct {
+	ID         uuid.UUID `gorm:"primaryKey,size:256" json:"id"`
+	BudidayaID uuid.UUID `json:"budidayaID"`
+	Date       time.Time `json:"date"`
+	Count      int       `json:"count"`
+	Cause      string    `json:"cause"`
+	Note       string    `json:"note"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+func (p *MortalityLogOutput) TableName() string {
+	return "mortality_logs"
+}
+
+type SamplingLogOutput struct {
+	ID            uuid.UUID `gorm:"primaryKey,size:256" json:"id"`
+	BudidayaID    uuid.UUID `json:"budidayaID"`
+	Date          time.Time `json:"date"`
+	SampleCount   int       `json:"sampleCount"`
+	AverageWeight float64   `json:"averageWeight"`
+	AverageLength float64   `json:"averageLength"`
+	Note          string    `json:"note"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+func (p *SamplingLogOutput) TableName() string {
+	return "sampling_logs"
+}
+
+type JournalOutput struct {
+	Feeding   []*FeedingLogOutput   `json:"feeding"`
+	Mortality []*MortalityLogOutput `json:"mortality"`
+	Sampling  []*SamplingLogOutput  `json:"sampling"`
+}
+
+type WaterQualityOutput struct {
+	ID              uuid.UUID                  `gorm:"primaryKey,size:256" json:"id"`
+	PondID          uuid.UUID                  `json:"pondID"`
+	PoolID          uuid.UUID                  `json:"poolID"`
+	BudidayaID      *uuid.UUID                 `json:"budidayaID,omitempty"`
+	MeasuredAt      time.Time                  `json:"measuredAt"`
+	PH              *float64                   `json:"ph,omitempty"`
+	DissolvedOxygen *float64                   `json:"dissolvedOxygen,omitempty"`
+	Temperature     *float64                   `json:"temperature,omitempty"`
+	Ammonia         *float64                   `json:"ammonia,omitempty"`
+	Salinity        *float64                   `json:"salinity,omitempty"`
+	Alerts          []*WaterQualityAlertOutput `gorm:"foreignKey:WaterQualityID;references:ID" json:"alerts,omitempty"`
+}
+
+func (p *WaterQualityOutput) TableName() string {
+	return "water_qualities"
+}
+
+type WaterQualityRangeOutput struct {
+	ID            uuid.UUID `gorm:"primaryKey,size:256" json:"id"`
+	FishSpeciesID uuid.UUID `json:"fishSpeciesID"`
+	Parameter     string    `json:"parameter"`
+	Min           *float64  `json:"min"`
+	Max           *float64  `json:"max"`
+}
+
+func (p *WaterQualityRangeOutput) TableName() string {
+	return "water_quality_ranges"
+}
+
+// IsOutOfRange check the value with the min and max of the range
+func (p *WaterQualityRangeOutput) IsOutOfRange(value float64) bool {
+	if p.Min != nil && value < *p.Min {
+		return true
+	}
+	if p.Max != nil && value > *p.Max {
+		return true
+	}
+	return false
+}
+
+type WaterQualityAlertOutput struct {
+	ID             uuid.UUID  `gorm:"primaryKey,size:256" json:"id"`
+	WaterQualityID uuid.UUID  `json:"waterQualityID"`
+	PoolID         uuid.UUID  `json:"poolID"`
+	BudidayaID     *uuid.UUID `json:"budidayaID,omitempty"`
+	Parameter      string     `json:"parameter"`
+	Value          float64    `json:"value"`
+	Min            *float64   `json:"min"`
+	Max            *float64   `json:"max"`
+	CreatedAt      time.Time  `json:"createdAt"`
+}
+
+func (p *WaterQualityAlertOutput) TableName() string {
+	return "water_quality_alerts"
+}
+
+// Message is the text of the alert notification, ex: ph 9.20 is out of the safe range (6.50 - 8.50)
+func (p *WaterQualityAlertOutput) Message() string {
+	var (
+		lower = "-"
+		upper = "-"
+	)
+
+	if p.Min != nil {
+		lower = fmt.Sprintf("%.2f", *p.Min)
+	}
+	if p.Max != nil {
+		upper = fmt.Sprintf("%.2f", *p.Max)
+	}
+
+	return fmt.Sprintf("%v %.2f is out of the safe range (%v - %v)", p.Parameter, p.Value, lower, upper)
+}
+
+// CreateWaterQualityOutput is the result of the bulk ingestion,
+// UserID is the owner of the pond that receive the alert notification
+type CreateWaterQualityOutput struct {
+	ListID []uuid.UUID                `json:"listID"`
+	Alerts []*WaterQualityAlertOutput `json:"alerts"`
+	UserID uuid.UUID                  `json:"-"`
+}
+
+type HarvestOutput struct {
+	ID           uuid.UUID             `gorm:"primaryKey,size:256" json:"id"`
+	BudidayaID   uuid.UUID             `json:"budidayaID"`
+	PoolID       uuid.UUID             `json:"poolID"`
+	Date         time.Time             `json:"date"`
+	ActualTonase float64               `json:"actualTonase"`
+	Sold         int                   `json:"sold"`
+	Leftover     float64               `json:"leftover"`
+	Note         string                `json:"note"`
+	Grades       []*HarvestGradeOutput `gorm:"foreignKey:HarvestID;references:ID" json:"grades"`
+}
+
+func (p *HarvestOutput) TableName() string {
+	return "harvests"
+}
+
+type HarvestGradeOutput struct {
+	ID        uuid.UUID `gorm:"primaryKey,size:256" json:"id"`
+	HarvestID uuid.UUID `json:"harvestID"`
+	Grade     string    `json:"grade"`
+	Qty       float64   `json:"qty"`
+}
+
+func (p *HarvestGradeOutput) TableName() string {
+	return "harvest_grades"
+}
@@ -28,6 +28,16 @@ type Budidaya struct {
 	EstPrice        int
 	Status          string
 	Sold            int
+	Reserved        int
+	SeedCount       int
+	SeedWeight      float64
+	SeedAge         int
+	SeedSize        float64
+	SeedSupplier    string
+	StockingDensity float64
+	TotalFeed       float64
+	TotalMortality  int
+	AverageWeight   float64
 	PriceList       []*PriceList
 	orm.OrmModel
 }
@@ -65,19 +75,205 @@ func GeneratedCodeBudidaya(pondName, last string) (string, error) {
 	return newCode, nil
 }
 
+// PriceList is immutable, the change of the price create the new version of the tier.
+// the version is used from EffectiveFrom until EffectiveTo, EffectiveTo is nil for the latest version
 type PriceList struct {
+	ID            uuid.UUID `gorm:"primaryKey,size:256"`
+	BudidayaID    uuid.UUID
+	Budidaya      Budidaya
+	Limit         int
+	Price         int
+	PreviousID    *uuid.UUID `gorm:"size:256"`
+	EffectiveFrom *time.Time
+	EffectiveTo   *time.Time
+	orm.OrmModel
+}
+
+// IsEffective check the version is used at the time,
+// the pricelist before the versioning has no effective date
+func (p *PriceList) IsEffective(at time.Time) bool {
+	if p.EffectiveFrom != nil && p.EffectiveFrom.After(at) {
+		return false
+	}
+	return p.EffectiveTo == nil || p.EffectiveTo.After(at)
+}
+
+type FishSpecies struct {
+	ID                uuid.UUID `gorm:"primaryKey,size:256"`
+	Name              string
+	Asal              string
+	Budidaya          []*Budidaya
+	WaterQualityRange []*WaterQualityRange
+	GrowthCurve
+	MaxDensity float64
+	orm.OrmModel
+}
+
+// FeedingLog is the daily feeding journal of the budidaya, the qty is in kg
+type FeedingLog struct {
 	ID         uuid.UUID `gorm:"primaryKey,size:256"`
-	BudidayaID uuid.UUID
+	BudidayaID uuid.UUID `gorm:"size:256"`
 	Budidaya   Budidaya
-	Limit      int
-	Price      int
+	Date       time.Time
+	FeedType   string
+	Qty        float64
+	Note       string
 	orm.OrmModel
 }
 
-type FishSpecies struct {
-	ID       uuid.UUID `gorm:"primaryKey,size:256"`
-	Name     string
-	Asal     string
-	Budidaya []*Budidaya
+// MortalityLog is the count of the dead fish in the budidaya
+type MortalityLog struct {
+	ID         uuid.UUID `gorm:"primaryKey,size:256"`
+	BudidayaID uuid.UUID `gorm:"size:256"`
+	Budidaya   Budidaya
+	Date       time.Time
+	Count      int
+	Cause      string
+	Note       string
 	orm.OrmModel
 }
+
+// SamplingLog is the periodic growth sampling of the budidaya,
+// the average weight is in gram and the average length is in cm
+type SamplingLog struct {
+	ID            uuid.UUID `gorm:"primaryKey,size:256"`
+	BudidayaID    uuid.UUID `gorm:"size:256"`
+	Budidaya      Budidaya
+	Date          time.Time
+	SampleCount   int
+	AverageWeight float64
+	AverageLength float64
+	Note          string
+	orm.OrmModel
+}
+
+// WaterQuality is the water quality reading of the pool, the parameter that is not measured is nil
+type WaterQuality struct {
+	ID              uuid.UUID  `gorm:"primaryKey,size:256"`
+	PondID          uuid.UUID  `gorm:"size:256"`
+	PoolID          uuid.UUID  `gorm:"size:256"`
+	BudidayaID      *uuid.UUID `gorm:"size:256"`
+	MeasuredAt      time.Time
+	PH              *float64
+	DissolvedOxygen *float64
+	Temperature     *float64
+	Ammonia         *float64
+	Salinity        *float64
+	orm.OrmModel
+}
+
+// Values map the parameter of the reading to the value
+func (w *WaterQuality) Values() map[string]*float64 {
+	return map[string]*float64{
+		PH:               w.PH,
+		DISSOLVED_OXYGEN: w.DissolvedOxygen,
+		TEMPERATURE:      w.Temperature,
+		AMMONIA:          w.Ammonia,
+		SALINITY:         w.Salinity,
+	}
+}
+
+// WaterQualityRange is the safe range of the water quality parameter for the fish species,
+// the nil min or max means the parameter has no lower or upper limit
+type WaterQualityRange struct {
+	ID            uuid.UUID `gorm:"primaryKey,size:256"`
+	FishSpeciesID uuid.UUID `gorm:"size:256"`
+	Parameter     string
+	Min           *float64
+	Max           *float64
+	orm.OrmModel
+}
+
+// WaterQualityAlert is the parameter of the reading that is out of the safe range
+type WaterQualityAlert struct {
+	ID             uuid.UUID  `gorm:"primaryKey,size:256"`
+	WaterQualityID uuid.UUID  `gorm:"size:256"`
+	PoolID         uuid.UUID  `gorm:"size:256"`
+	BudidayaID     *uuid.UUID `gorm:"size:256"`
+	Parameter      string
+	Value          float64
+	Min            *float64
+	Max            *float64
+	orm.OrmModel
+}
+
+// GenerateWaterQualityAlert check every parameter of the reading with the safe range of the fish species
+func GenerateWaterQualityAlert(reading WaterQuality, ranges []*WaterQualityRangeOutput, userID uuid.UUID) []WaterQualityAlert {
+	var (
+		alerts = []WaterQualityAlert{}
+		values = reading.Values()
+	)
+
+	for _, v := range ranges {
+		value := values[v.Parameter]
+		if value == nil {
+			continue
+		}
+
+		if !v.IsOutOfRange(*value) {
+			continue
+		}
+
+		alerts = append(alerts, WaterQualityAlert{
+			ID:             uuid.New(),
+			WaterQualityID: reading.ID,
+			PoolID:         reading.PoolID,
+			BudidayaID:     reading.BudidayaID,
+			Parameter:      v.Parameter,
+			Value:          *value,
+			Min:            v.Min,
+			Max:            v.Max,
+			OrmModel: orm.OrmModel{
+				CreatedAt: time.Now(),
+				CreatedBy: userID,
+			},
+		})
+	}
+
+	return alerts
+}
+
+func (w *WaterQualityAlert) ToWaterQualityAlertOutput() *WaterQualityAlertOutput {
+	return &WaterQualityAlertOutput{
+		ID:             w.ID,
+		WaterQualityID: w.WaterQualityID,
+		PoolID:         w.PoolID,
+		BudidayaID:     w.BudidayaID,
+		Parameter:      w.Parameter,
+		Value:          w.Value,
+		Min:            w.Min,
+		Max:            w.Max,
+		CreatedAt:      w.CreatedAt,
+	}
+}
+
+// Harvest close the budidaya, the leftover is the harvested tonase that is not sold
+type Harvest struct {
+	ID           uuid.UUID `gorm:"primaryKey,size:256"`
+	BudidayaID   uuid.UUID `gorm:"size:256"`
+	PoolID       uuid.UUID `gorm:"size:256"`
+	Date         time.Time
+	ActualTonase float64
+	Sold         int
+	Leftover     float64
+	Note         string
+	Grades       []*HarvestGrade
+	orm.OrmModel
+}
+
+type HarvestGrade struct {
+	ID        uuid.UUID `gorm:"primaryKey,size:256"`
+	HarvestID uuid.UUID `gorm:"size:256"`
+	Grade     string
+	Qty       float64
+	orm.OrmModel
+}
+
+// StockingDensity is the number of the seed per m2 of the pool
+func StockingDensity(seedCount int, long, wide float64) float64 {
+	area := long * wide
+	if area <= 0 {
+		return 0
+	}
+	return float64(seedCount) / area
+}
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"math"
 	"time"
 
 	"github.com/e-fish/api/pkg/common/helper/werror"
@@ -14,6 +15,11 @@ type CreateBudidayaInput struct {
 	PoolID        uuid.UUID `json:"poolID"`
 	DateOfSeed    time.Time `json:"dateOfSeed"`
 	FishSpeciesID uuid.UUID `json:"fishSpeciesID"`
+	SeedCount     int       `json:"seedCount"`
+	SeedWeight    float64   `json:"seedWeight"`
+	SeedAge       int       `json:"seedAge"`
+	SeedSize      float64   `json:"seedSize"`
+	SeedSupplier  string    `json:"seedSupplier"`
 }
 
 func (c *CreateBudidayaInput) Validate() error {
@@ -28,6 +34,18 @@ func (c *CreateBudidayaInput) Validate() error {
 	if c.FishSpeciesID == uuid.Nil {
 		errs.Add(errorbudidaya.ErrValidateInputBudidaya.AttacthDetail(map[string]any{"fishSpeciesID": "empty"}))
 	}
+	if c.SeedCount < 1 {
+		errs.Add(errorbudidaya.ErrValidateInputBudidaya.AttacthDetail(map[string]any{"seedCount": "must be more than 0"}))
+	}
+	if c.SeedWeight < 0 {
+		errs.Add(errorbudidaya.ErrValidateInputBudidaya.AttacthDetail(map[string]any{"seedWeight": "can't be negative"}))
+	}
+	if c.SeedAge < 0 {
+		errs.Add(errorbudidaya.ErrValidateInputBudidaya.AttacthDetail(map[string]any{"seedAge": "can't be negative"}))
+	}
+	if c.SeedSize < 0 {
+		errs.Add(errorbudidaya.ErrValidateInputBudidaya.AttacthDetail(map[string]any{"seedSize": "can't be negative"}))
+	}
 
 	return errs.Return()
 }
@@ -40,6 +58,11 @@ func (c *CreateBudidayaInput) ToBudidaya(userID, pondID uuid.UUID) Budidaya {
 		PoolID:        c.PoolID,
 		DateOfSeed:    c.DateOfSeed,
 		FishSpeciesID: c.FishSpeciesID,
+		SeedCount:     c.SeedCount,
+		SeedWeight:    c.SeedWeight,
+		SeedAge:       c.SeedAge,
+		SeedSize:      c.SeedSize,
+		SeedSupplier:  c.SeedSupplier,
 		Status:        BUDIDAYA,
 		OrmModel: orm.OrmModel{
 			CreatedAt: time.Now(),
@@ -55,19 +78,36 @@ type UpdateBudidayaStatusInput struct {
 	Status    string    `json:"status"`
 }
 
+func (c *UpdateBudidayaStatusInput) Validate() error {
+	errs := werror.NewError("failed validate update status budidaya")
+
+	if c.ID == uuid.Nil {
+		errs.Add(errorbudidaya.ErrCannotUpdateStatusBudidaya.AttacthDetail(map[string]any{"id": "empty"}))
+	}
+	if _, ok := ValidateStatus[c.Status]; !ok {
+		errs.Add(errorbudidaya.ErrCannotUpdateStatusBudidaya.AttacthDetail(map[string]any{"status": "invalid"}))
+	}
+
+	return errs.Return()
+}
+
 func (c *UpdateBudidayaStatusInput) ToBudidaya(userID uuid.UUID) Budidaya {
 	today := time.Now()
 
-	return Budidaya{
-		ID:           c.ID,
-		Status:       c.Status,
-		EstTonase:    float64(c.EstTonase),
-		EstPanenDate: &c.EstDate,
+	budidaya := Budidaya{
+		ID:        c.ID,
+		Status:    c.Status,
+		EstTonase: float64(c.EstTonase),
 		OrmModel: orm.OrmModel{
 			UpdatedBy: &userID,
 			UpdatedAt: &today,
 		},
 	}
+	if !c.EstDate.IsZero() {
+		budidaya.EstPanenDate = &c.EstDate
+	}
+
+	return budidaya
 }
 
 type CreateMultiplePriceListInput struct {
@@ -90,6 +130,7 @@ func (c *CreateMultiplePriceListInput) Validate() error {
 		errs.Add(errorbudidaya.ErrValidateInputPriceList.AttacthDetail(map[string]any{"estDate": "empty"}))
 	}
 
+	tier := []PriceList{}
 	for _, v := range c.Input {
 		v.BudidayaID = c.BudidayaID
 		v.EstTonase = c.EstTonase
@@ -98,18 +139,26 @@ func (c *CreateMultiplePriceListInput) Validate() error {
 		if err != nil {
 			errs.Add(errorbudidaya.ErrValidateMultipleInputPriceList.AttacthDetail(map[string]any{"limit": v.Limit, "error": err}))
 		}
+		tier = append(tier, PriceList{Limit: v.Limit, Price: v.Price})
+	}
+
+	if err := ValidatePricelistTier(tier); err != nil {
+		errs.Add(err)
 	}
 
 	return errs.Return()
 }
 
 func (c *CreateMultiplePriceListInput) ToMultiplePriceList(userID uuid.UUID) (newPricelist []PriceList) {
+	today := time.Now()
+
 	for _, v := range c.Input {
 		newPricelist = append(newPricelist, PriceList{
-			ID:         uuid.New(),
-			BudidayaID: c.BudidayaID,
-			Limit:      v.Limit,
-			Price:      v.Price,
+			ID:            uuid.New(),
+			BudidayaID:    c.BudidayaID,
+			Limit:         v.Limit,
+			Price:         v.Price,
+			EffectiveFrom: &today,
 			OrmModel: orm.OrmModel{
 				CreatedAt: time.Now(),
 				CreatedBy: userID,
@@ -151,11 +200,14 @@ func (c *CreatePriceListInput) Validate() error {
 }
 
 func (c *CreatePriceListInput) ToPriceList(userID uuid.UUID) PriceList {
+	today := time.Now()
+
 	return PriceList{
-		ID:         uuid.New(),
-		BudidayaID: c.BudidayaID,
-		Limit:      c.Limit,
-		Price:      c.Price,
+		ID:            uuid.New(),
+		BudidayaID:    c.BudidayaID,
+		Limit:         c.Limit,
+		Price:         c.Price,
+		EffectiveFrom: &today,
 		OrmModel: orm.OrmModel{
 			CreatedAt: time.Now(),
 			CreatedBy: userID,
@@ -166,6 +218,8 @@ func (c *CreatePriceListInput) ToPriceList(userID uuid.UUID) PriceList {
 type CreateFishSpeciesInput struct {
 	Name string `json:"name,omitempty"`
 	Asal string `json:"asal,omitempty"`
+	// MaxDensity is the maximum fish per m2 of the pool, 0 is unlimited
+	MaxDensity float64 `json:"maxDensity,omitempty"`
 }
 
 func (c *CreateFishSpeciesInput) Validate() error {
@@ -177,16 +231,20 @@ func (c *CreateFishSpeciesInput) Validate() error {
 	if c.Asal == "" {
 		errs.Add(errorbudidaya.ErrValidateInputFishSpecies.AttacthDetail(map[string]any{"name": "empty"}))
 	}
+	if c.MaxDensity < 0 {
+		errs.Add(errorbudidaya.ErrValidateInputFishSpecies.AttacthDetail(map[string]any{"maxDensity": "can't be negative"}))
+	}
 
 	return errs.Return()
 }
 
 func (c *CreateFishSpeciesInput) ToFishSpecies(userID uuid.UUID) FishSpecies {
 	return FishSpecies{
-		ID:       uuid.New(),
-		Name:     c.Name,
-		Asal:     c.Asal,
-		OrmModel: orm.OrmModel{CreatedAt: time.Now(), CreatedBy: userID},
+		ID:         uuid.New(),
+		Name:       c.Name,
+		Asal:       c.Asal,
+		MaxDensity: c.MaxDensity,
+		OrmModel:   orm.OrmModel{CreatedAt: time.Now(), CreatedBy: userID},
 	}
 }
 
@@ -256,6 +314,8 @@ type UpdatePriceListInput struct {
 	EstDate    time.Time `json:"estDate"`
 	Limit      int       `json:"limit"`
 	Price      int       `json:"price"`
+	// EffectiveFrom schedule the new price, the new price is used immediately when it is empty
+	EffectiveFrom *time.Time `json:"effectiveFrom"`
 }
 
 func (u *UpdatePriceListInput) Validate() error {
@@ -269,6 +329,18 @@ func (u *UpdatePriceListInput) Validate() error {
 		errs.Add(errorbudidaya.ErrFailedUpdateBudidaya.AttacthDetail(map[string]any{"budidayaID": "empty"}))
 	}
 
+	if u.Limit < 1 {
+		errs.Add(errorbudidaya.ErrFailedUpdateBudidaya.AttacthDetail(map[string]any{"limit": "empty"}))
+	}
+
+	if u.Price < 1 {
+		errs.Add(errorbudidaya.ErrFailedUpdateBudidaya.AttacthDetail(map[string]any{"price": "empty"}))
+	}
+
+	if u.EffectiveFrom != nil && u.EffectiveFrom.Before(time.Now().Add(-time.Minute)) {
+		errs.Add(errorbudidaya.ErrFailedUpdateBudidaya.AttacthDetail(map[string]any{"effectiveFrom": "can't be in the past"}))
+	}
+
 	if err := errs.Return(); err != nil {
 		return err
 	}
@@ -276,20 +348,30 @@ func (u *UpdatePriceListInput) Validate() error {
 	return nil
 }
 
+// UpdatePricelistInputToPricelist create the new version of the pricelist,
+// the old version is closed by the command when the new version is effective
 func UpdatePricelistInputToPricelist(input []UpdatePriceListInput, userID uuid.UUID) []*PriceList {
 	var (
 		pricelist []*PriceList
 		today     = time.Now()
 	)
 	for _, price := range input {
+		previousID := price.ID
+		effectiveFrom := today
+		if price.EffectiveFrom != nil && price.EffectiveFrom.After(today) {
+			effectiveFrom = *price.EffectiveFrom
+		}
+
 		pricelist = append(pricelist, &PriceList{
-			ID:         price.ID,
-			BudidayaID: price.BudidayaID,
-			Limit:      price.Limit,
-			Price:      price.Price,
+			ID:            uuid.New(),
+			BudidayaID:    price.BudidayaID,
+			Limit:         price.Limit,
+			Price:         price.Price,
+			PreviousID:    &previousID,
+			EffectiveFrom: &effectiveFrom,
 			OrmModel: orm.OrmModel{
-				UpdatedAt: &today,
-				UpdatedBy: &userID,
+				CreatedAt: today,
+				CreatedBy: userID,
 			},
 		})
 	}
@@ -297,8 +379,370 @@ func UpdatePricelistInputToPricelist(input []UpdatePriceListInput, userID uuid.U
 	return pricelist
 }
 
+// UpdateBudidayaReservedQty hold the qty of the order until it is confirmed
+type UpdateBudidayaReservedQty struct {
+	ID     uuid.UUID `json:"id"`
+	Qty    int       `json:"qty"`
+	Action string    `json:"action"`
+}
+
 type UpdateBudidayaSoldQty struct {
 	ID       uuid.UUID `json:"id"`
 	SoldQty  int       `json:"sold"`
 	IsCancel bool      `json:"isCancel"`
 }
+
+type CreateFeedingLogInput struct {
+	BudidayaID uuid.UUID `json:"budidayaID"`
+	Date       time.Time `json:"date"`
+	FeedType   string    `json:"feedType"`
+	Qty        float64   `json:"qty"`
+	Note       string    `json:"note"`
+}
+
+func (c *CreateFeedingLogInput) Validate() error {
+	errs := werror.NewError("failed validate create input feeding log")
+
+	if c.BudidayaID == uuid.Nil {
+		errs.Add(errorbudidaya.ErrValidateInputJournal.AttacthDetail(map[string]any{"budidayaID": "empty"}))
+	}
+	if c.Date.IsZero() {
+		errs.Add(errorbudidaya.ErrValidateInputJournal.AttacthDetail(map[string]any{"date": "empty"}))
+	}
+	if c.FeedType == "" {
+		errs.Add(errorbudidaya.ErrValidateInputJournal.AttacthDetail(map[string]any{"feedType": "empty"}))
+	}
+	if c.Qty <= 0 {
+		errs.Add(errorbudidaya.ErrValidateInputJournal.AttacthDetail(map[string]any{"qty": "must be more than 0"}))
+	}
+
+	return errs.Return()
+}
+
+func (c *CreateFeedingLogInput) ToFeedingLog(userID uuid.UUID) FeedingLog {
+	return FeedingLog{
+		ID:         uuid.New(),
+		BudidayaID: c.BudidayaID,
+		Date:       c.Date,
+		FeedType:   c.FeedType,
+		Qty:        c.Qty,
+		Note:       c.Note,
+		OrmModel: orm.OrmModel{
+			CreatedAt: time.Now(),
+			CreatedBy: userID,
+		},
+	}
+}
+
+type CreateMortalityLogInput struct {
+	BudidayaID uuid.UUID `json:"budidayaID"`
+	Date       time.Time `json:"date"`
+	Count      int       `json:"count"`
+	Cause      string    `json:"cause"`
+	Note       string    `json:"note"`
+}
+
+func (c *CreateMortalityLogInput) Validate() error {
+	errs := werror.NewError("failed validate create input mortality log")
+
+	if c.BudidayaID == uuid.Nil {
+		errs.Add(errorbudidaya.ErrValidateInputJournal.AttacthDetail(map[string]any{"budidayaID": "empty"}))
+	}
+	if c.Date.IsZero() {
+		errs.Add(errorbudidaya.ErrValidateInputJournal.AttacthDetail(map[string]any{"date": "empty"}))
+	}
+	if c.Count < 1 {
+		errs.Add(errorbudidaya.ErrValidateInputJournal.AttacthDetail(map[string]any{"count": "must be more than 0"}))
+	}
+
+	return errs.Return()
+}
+
+func (c *CreateMortalityLogInput) ToMortalityLog(userID uuid.UUID) MortalityLog {
+	return MortalityLog{
+		ID:         uuid.New(),
+		BudidayaID: c.BudidayaID,
+		Date:       c.Date,
+		Count:      c.Count,
+		Cause:      c.Cause,
+		Note:       c.Note,
+		OrmModel: orm.OrmModel{
+			CreatedAt: time.Now(),
+			CreatedBy: userID,
+		},
+	}
+}
+
+type CreateSamplingLogInput struct {
+	BudidayaID    uuid.UUID `json:"budidayaID"`
+	Date          time.Time `json:"date"`
+	SampleCount   int       `json:"sampleCount"`
+	AverageWeight float64   `json:"averageWeight"`
+	AverageLength float64   `json:"averageLength"`
+	Note          string    `json:"note"`
+}
+
+func (c *CreateSamplingLogInput) Validate() error {
+	errs := werror.NewError("failed validate create input sampling log")
+
+	if c.BudidayaID == uuid.Nil {
+		errs.Add(errorbudidaya.ErrValidateInputJournal.AttacthDetail(map[string]any{"budidayaID": "empty"}))
+	}
+	if c.Date.IsZero() {
+		errs.Add(errorbudidaya.ErrValidateInputJournal.AttacthDetail(map[string]any{"date": "empty"}))
+	}
+	if c.SampleCount < 1 {
+		errs.Add(errorbudidaya.ErrValidateInputJournal.AttacthDetail(map[string]any{"sampleCount": "must be more than 0"}))
+	}
+	if c.AverageWeight <= 0 {
+		errs.Add(errorbudidaya.ErrValidateInputJournal.AttacthDetail(map[string]any{"averageWeight": "must be more than 0"}))
+	}
+	if c.AverageLength < 0 {
+		errs.Add(errorbudidaya.ErrValidateInputJournal.AttacthDetail(map[string]any{"averageLength": "can't be negative"}))
+	}
+
+	return errs.Return()
+}
+
+func (c *CreateSamplingLogInput) ToSamplingLog(userID uuid.UUID) SamplingLog {
+	return SamplingLog{
+		ID:            uuid.New(),
+		BudidayaID:    c.BudidayaID,
+		Date:          c.Date,
+		SampleCount:   c.SampleCount,
+		AverageWeight: c.AverageWeight,
+		AverageLength: c.AverageLength,
+		Note:          c.Note,
+		OrmModel: orm.OrmModel{
+			CreatedAt: time.Now(),
+			CreatedBy: userID,
+		},
+	}
+}
+
+type CreateWaterQualityInput struct {
+	PoolID          uuid.UUID `json:"poolID"`
+	MeasuredAt      time.Time `json:"measuredAt"`
+	PH              *float64  `json:"ph"`
+	DissolvedOxygen *float64  `json:"dissolvedOxygen"`
+	Temperature     *float64  `json:"temperature"`
+	Ammonia         *float64  `json:"ammonia"`
+	Salinity        *float64  `json:"salinity"`
+}
+
+func (c *CreateWaterQualityInput) Validate() error {
+	errs := werror.NewError("failed validate create input water quality")
+
+	if c.PoolID == uuid.Nil {
+		errs.Add(errorbudidaya.ErrValidateInputWaterQuality.AttacthDetail(map[string]any{"poolID": "empty"}))
+	}
+	if c.MeasuredAt.IsZero() {
+		errs.Add(errorbudidaya.ErrValidateInputWaterQuality.AttacthDetail(map[string]any{"measuredAt": "empty"}))
+	}
+	if c.PH == nil && c.DissolvedOxygen == nil && c.Temperature == nil && c.Ammonia == nil && c.Salinity == nil {
+		errs.Add(errorbudidaya.ErrValidateInputWaterQuality.AttacthDetail(map[string]any{"parameter": "empty"}))
+	}
+	if c.PH != nil && (*c.PH < 0 || *c.PH > 14) {
+		errs.Add(errorbudidaya.ErrValidateInputWaterQuality.AttacthDetail(map[string]any{"ph": "must be between 0 and 14"}))
+	}
+	if c.DissolvedOxygen != nil && *c.DissolvedOxygen < 0 {
+		errs.Add(errorbudidaya.ErrValidateInputWaterQuality.AttacthDetail(map[string]any{"dissolvedOxygen": "can't be negative"}))
+	}
+	if c.Ammonia != nil && *c.Ammonia < 0 {
+		errs.Add(errorbudidaya.ErrValidateInputWaterQuality.AttacthDetail(map[string]any{"ammonia": "can't be negative"}))
+	}
+	if c.Salinity != nil && *c.Salinity < 0 {
+		errs.Add(errorbudidaya.ErrValidateInputWaterQuality.AttacthDetail(map[string]any{"salinity": "can't be negative"}))
+	}
+
+	return errs.Return()
+}
+
+func (c *CreateWaterQualityInput) ToWaterQuality(userID, pondID uuid.UUID, budidayaID *uuid.UUID) WaterQuality {
+	return WaterQuality{
+		ID:              uuid.New(),
+		PondID:          pondID,
+		PoolID:          c.PoolID,
+		BudidayaID:      budidayaID,
+		MeasuredAt:      c.MeasuredAt,
+		PH:              c.PH,
+		DissolvedOxygen: c.DissolvedOxygen,
+		Temperature:     c.Temperature,
+		Ammonia:         c.Ammonia,
+		Salinity:        c.Salinity,
+		OrmModel: orm.OrmModel{
+			CreatedAt: time.Now(),
+			CreatedBy: userID,
+		},
+	}
+}
+
+// CreateMultipleWaterQualityInput is used by the sensor or the worker to send many readings at once
+type CreateMultipleWaterQualityInput struct {
+	Input []CreateWaterQualityInput `json:"input"`
+}
+
+func (c *CreateMultipleWaterQualityInput) Validate() error {
+	errs := werror.NewError("failed validate create input water quality")
+
+	if len(c.Input) < 1 {
+		errs.Add(errorbudidaya.ErrValidateInputWaterQuality.AttacthDetail(map[string]any{"input": "empty"}))
+	}
+
+	for i, v := range c.Input {
+		err := v.Validate()
+		if err != nil {
+			errs.Add(errorbudidaya.ErrValidateInputWaterQuality.AttacthDetail(map[string]any{"index": i, "error": err}))
+		}
+	}
+
+	return errs.Return()
+}
+
+type WaterQualityRangeInput struct {
+	Parameter string   `json:"parameter"`
+	Min       *float64 `json:"min"`
+	Max       *float64 `json:"max"`
+}
+
+type UpdateWaterQualityRangeInput struct {
+	FishSpeciesID uuid.UUID                `json:"fishSpeciesID"`
+	Input         []WaterQualityRangeInput `json:"input"`
+}
+
+func (c *UpdateWaterQualityRangeInput) Validate() error {
+	var (
+		errs      = werror.NewError("failed validate update input water quality range")
+		parameter = map[string]bool{}
+	)
+
+	if c.FishSpeciesID == uuid.Nil {
+		errs.Add(errorbudidaya.ErrValidateInputWaterQuality.AttacthDetail(map[string]any{"fishSpeciesID": "empty"}))
+	}
+
+	for _, v := range c.Input {
+		if !WaterQualityParameter[v.Parameter] {
+			errs.Add(errorbudidaya.ErrValidateInputWaterQuality.AttacthDetail(map[string]any{"parameter": v.Parameter}))
+		}
+		if parameter[v.Parameter] {
+			errs.Add(errorbudidaya.ErrValidateInputWaterQuality.AttacthDetail(map[string]any{"parameter": v.Parameter, "error": "duplicate"}))
+		}
+		if v.Min == nil && v.Max == nil {
+			errs.Add(errorbudidaya.ErrValidateInputWaterQuality.AttacthDetail(map[string]any{"parameter": v.Parameter, "error": "min and max empty"}))
+		}
+		if v.Min != nil && v.Max != nil && *v.Min > *v.Max {
+			errs.Add(errorbudidaya.ErrValidateInputWaterQuality.AttacthDetail(map[string]any{"parameter": v.Parameter, "error": "min bigger than max"}))
+		}
+		parameter[v.Parameter] = true
+	}
+
+	return errs.Return()
+}
+
+func (c *UpdateWaterQualityRangeInput) ToWaterQualityRange(userID uuid.UUID) []WaterQualityRange {
+	ranges := []WaterQualityRange{}
+	for _, v := range c.Input {
+		ranges = append(ranges, WaterQualityRange{
+			ID:            uuid.New(),
+			FishSpeciesID: c.FishSpeciesID,
+			Parameter:     v.Parameter,
+			Min:           v.Min,
+			Max:           v.Max,
+			OrmModel: orm.OrmModel{
+				CreatedAt: time.Now(),
+				CreatedBy: userID,
+			},
+		})
+	}
+	return ranges
+}
+
+type ReadWaterQualityInput struct {
+	PoolID    uuid.UUID `json:"poolID"`
+	StartDate time.Time `json:"startDate"`
+	EndDate   time.Time `json:"endDate"`
+}
+
+type HarvestGradeInput struct {
+	Grade string  `json:"grade"`
+	Qty   float64 `json:"qty"`
+}
+
+type CreateHarvestInput struct {
+	BudidayaID   uuid.UUID           `json:"budidayaID"`
+	Date         time.Time           `json:"date"`
+	ActualTonase float64             `json:"actualTonase"`
+	Grades       []HarvestGradeInput `json:"grades"`
+	Note         string              `json:"note"`
+}
+
+// Validate check the total qty of the grades is the same as the actual tonase
+func (c *CreateHarvestInput) Validate() error {
+	var (
+		errs  = werror.NewError("failed validate create input harvest")
+		total float64
+	)
+
+	if c.BudidayaID == uuid.Nil {
+		errs.Add(errorbudidaya.ErrValidateInputHarvest.AttacthDetail(map[string]any{"budidayaID": "empty"}))
+	}
+	if c.Date.IsZero() {
+		errs.Add(errorbudidaya.ErrValidateInputHarvest.AttacthDetail(map[string]any{"date": "empty"}))
+	}
+	if c.ActualTonase < 0 {
+		errs.Add(errorbudidaya.ErrValidateInputHarvest.AttacthDetail(map[string]any{"actualTonase": "can't be negative"}))
+	}
+
+	for _, v := range c.Grades {
+		if v.Grade == "" {
+			errs.Add(errorbudidaya.ErrValidateInputHarvest.AttacthDetail(map[string]any{"grade": "empty"}))
+		}
+		if v.Qty <= 0 {
+			errs.Add(errorbudidaya.ErrValidateInputHarvest.AttacthDetail(map[string]any{"grade": v.Grade, "qty": "must be more than 0"}))
+		}
+		total += v.Qty
+	}
+
+	if len(c.Grades) > 0 && math.Abs(total-c.ActualTonase) > 0.001 {
+		errs.Add(errorbudidaya.ErrValidateInputHarvest.AttacthDetail(map[string]any{"grades": "total qty is not the same as the actual tonase", "total": total}))
+	}
+
+	return errs.Return()
+}
+
+func (c *CreateHarvestInput) ToHarvest(userID uuid.UUID, budidaya BudidayaOutput) Harvest {
+	var (
+		id     = uuid.New()
+		grades = []*HarvestGrade{}
+		today  = time.Now()
+	)
+
+	for _, v := range c.Grades {
+		grades = append(grades, &HarvestGrade{
+			ID:        uuid.New(),
+			HarvestID: id,
+			Grade:     v.Grade,
+			Qty:       v.Qty,
+			OrmModel: orm.OrmModel{
+				CreatedAt: today,
+				CreatedBy: userID,
+			},
+		})
+	}
+
+	return Harvest{
+		ID:           id,
+		BudidayaID:   budidaya.ID,
+		PoolID:       budidaya.PoolID,
+		Date:         c.Date,
+		ActualTonase: c.ActualTonase,
+		Sold:         budidaya.Sold,
+		Leftover:     c.ActualTonase - float64(budidaya.Sold),
+		Note:         c.Note,
+		Grades:       grades,
+		OrmModel: orm.OrmModel{
+			CreatedAt: today,
+			CreatedBy: userID,
+		},
+	}
+}
@@ -1,7 +1,85 @@
 package model
 
+import (
+	userModel "github.com/e-fish/api/pkg/domain/auth/model"
+)
+
+// budidaya lifecycle
+// BUDIDAYA is the growing period, PANEN is set when the pricelist and the harvest estimation is created,
+// END is set by the harvest and FAILED is for the cycle that is lost, ex: disease
 const (
 	BUDIDAYA = "budidaya"
 	PANEN    = "panen"
 	END      = "ended"
+	FAILED   = "failed"
+)
+
+var ValidateStatus = map[string]map[string]bool{
+	BUDIDAYA: {
+		PANEN:  true,
+		FAILED: true,
+	},
+	PANEN: {
+		PANEN:  true,
+		END:    true,
+		FAILED: true,
+	},
+	END:    {},
+	FAILED: {},
+}
+
+// ValidateActorStatus list the status that can be set by each actor,
+// END is not listed because it is only set by the harvest
+var ValidateActorStatus = map[string]map[string]bool{
+	userModel.SELLER: {
+		PANEN:  true,
+		FAILED: true,
+	},
+	userModel.ADMIN: {
+		PANEN:  true,
+		FAILED: true,
+	},
+}
+
+// ClosedStatus is the status of the budidaya that is not used by the pool anymore
+var ClosedStatus = []string{END, FAILED}
+
+func CanUpdateStatus(actor, from, to string) bool {
+	if !ValidateStatus[from][to] {
+		return false
+	}
+	return ValidateActorStatus[actor][to]
+}
+
+func IsClosedStatus(status string) bool {
+	for _, v := range ClosedStatus {
+		if v == status {
+			return true
+		}
+	}
+	return false
+}
+
+// parameter of the water quality
+const (
+	PH               = "ph"
+	DISSOLVED_OXYGEN = "dissolvedOxygen"
+	TEMPERATURE      = "temperature"
+	AMMONIA          = "ammonia"
+	SALINITY         = "salinity"
+)
+
+var WaterQualityParameter = map[string]bool{
+	PH:               true,
+	DISSOLVED_OXYGEN: true,
+	TEMPERATURE:      true,
+	AMMONIA:          true,
+	SALINITY:         true,
+}
+
+// action of the reserved qty
+const (
+	RESERVE = "reserve"
+	RELEASE = "release"
+	CONFIRM = "confirm"
 )
@@ -0,0 +1,158 @@
+package model
+
+import (
+	"math"
+	"time"
+
+	"github.com/e-fish/api/pkg/common/helper/werror"
+	errorbudidaya "github.com/e-fish/api/pkg/domain/budidaya/error-budidaya"
+	"github.com/google/uuid"
+)
+
+// GrowthCurve is the von Bertalanffy growth parameter of the fish species,
+// W(t) = AsymptoticWeight * (1 - e^(-GrowthRate * t))^3, the weight is in gram and t is in day.
+// GrowthDeviation is the uncertainty of the growth rate that is used for the confidence range, ex: 0.1 is 10%
+type GrowthCurve struct {
+	AsymptoticWeight float64 `json:"asymptoticWeight"`
+	GrowthRate       float64 `json:"growthRate"`
+	HarvestWeight    float64 `json:"harvestWeight"`
+	GrowthDeviation  float64 `json:"growthDeviation"`
+}
+
+func (g GrowthCurve) IsEmpty() bool {
+	return g.AsymptoticWeight <= 0 || g.GrowthRate <= 0 || g.HarvestWeight <= 0
+}
+
+// Age is the number of days needed by the fish to grow to the weight
+func (g GrowthCurve) Age(weight, growthRate float64) float64 {
+	if weight <= 0 {
+		return 0
+	}
+	ratio := math.Cbrt(weight / g.AsymptoticWeight)
+	if ratio >= 1 {
+		return math.Inf(1)
+	}
+	return -math.Log(1-ratio) / growthRate
+}
+
+// Remaining is the number of days needed by the fish to grow from the weight to the harvest weight
+func (g GrowthCurve) Remaining(weight, growthRate float64) float64 {
+	if weight >= g.HarvestWeight {
+		return 0
+	}
+	return g.Age(g.HarvestWeight, growthRate) - g.Age(weight, growthRate)
+}
+
+type UpdateGrowthCurveInput struct {
+	FishSpeciesID uuid.UUID `json:"fishSpeciesID"`
+	GrowthCurve
+}
+
+func (c *UpdateGrowthCurveInput) Validate() error {
+	errs := werror.NewError("failed validate update input growth curve")
+
+	if c.FishSpeciesID == uuid.Nil {
+		errs.Add(errorbudidaya.ErrValidateInputGrowthCurve.AttacthDetail(map[string]any{"fishSpeciesID": "empty"}))
+	}
+	if c.AsymptoticWeight <= 0 {
+		errs.Add(errorbudidaya.ErrValidateInputGrowthCurve.AttacthDetail(map[string]any{"asymptoticWeight": "must be more than 0"}))
+	}
+	if c.GrowthRate <= 0 {
+		errs.Add(errorbudidaya.ErrValidateInputGrowthCurve.AttacthDetail(map[string]any{"growthRate": "must be more than 0"}))
+	}
+	if c.HarvestWeight <= 0 || c.HarvestWeight >= c.AsymptoticWeight {
+		errs.Add(errorbudidaya.ErrValidateInputGrowthCurve.AttacthDetail(map[string]any{"harvestWeight": "must be more than 0 and less than asymptoticWeight"}))
+	}
+	if c.GrowthDeviation < 0 || c.GrowthDeviation >= 1 {
+		errs.Add(errorbudidaya.ErrValidateInputGrowthCurve.AttacthDetail(map[string]any{"growthDeviation": "must be between 0 and 1"}))
+	}
+
+	return errs.Return()
+}
+
+func (c *UpdateGrowthCurveInput) ToMap(userID uuid.UUID) map[string]any {
+	return map[string]any{
+		"asymptotic_weight": c.AsymptoticWeight,
+		"growth_rate":       c.GrowthRate,
+		"harvest_weight":    c.HarvestWeight,
+		"growth_deviation":  c.GrowthDeviation,
+		"updated_at":        time.Now(),
+		"updated_by":        &userID,
+	}
+}
+
+type HarvestPredictionOutput struct {
+	BudidayaID    uuid.UUID `json:"budidayaID"`
+	CurrentWeight float64   `json:"currentWeight"`
+	HarvestWeight float64   `json:"harvestWeight"`
+	Alive         int       `json:"alive"`
+	EstDate       time.Time `json:"estDate"`
+	EstDateMin    time.Time `json:"estDateMin"`
+	EstDateMax    time.Time `json:"estDateMax"`
+	EstTonase     float64   `json:"estTonase"`
+	EstTonaseMin  float64   `json:"estTonaseMin"`
+	EstTonaseMax  float64   `json:"estTonaseMax"`
+}
+
+// PredictHarvest estimate the harvest date and tonase from the last sampling, or the seed when it is not sampled yet.
+// the date range come from the growth deviation and the tonase range come from the mortality,
+// the lowest tonase expect the mortality keep going until the latest date and the highest expect no more mortality
+func PredictHarvest(budidaya BudidayaOutput, curve GrowthCurve, sampling *SamplingLogOutput, now time.Time) (*HarvestPredictionOutput, error) {
+	if curve.IsEmpty() {
+		return nil, errorbudidaya.ErrGrowthCurveEmpty.AttacthDetail(map[string]any{"fishSpeciesID": budidaya.FishSpeciesID})
+	}
+	if budidaya.SeedCount < 1 || budidaya.SeedWeight <= 0 {
+		return nil, errorbudidaya.ErrPredictHarvest.AttacthDetail(map[string]any{"seed": "empty"})
+	}
+
+	var (
+		weight = budidaya.SeedWeight
+		date   = budidaya.DateOfSeed
+		alive  = budidaya.SeedCount - budidaya.TotalMortality
+	)
+
+	if sampling != nil && sampling.AverageWeight > 0 {
+		weight = sampling.AverageWeight
+		date = sampling.Date
+	}
+	if alive < 0 {
+		alive = 0
+	}
+
+	var (
+		remaining    = curve.Remaining(weight, curve.GrowthRate)
+		remainingMin = curve.Remaining(weight, curve.GrowthRate*(1+curve.GrowthDeviation))
+		remainingMax = curve.Remaining(weight, curve.GrowthRate*(1-curve.GrowthDeviation))
+	)
+
+	// mortality per day since the seed, it is used to project the alive fish at the harvest date
+	var mortalityRate float64
+	if elapsed := now.Sub(budidaya.DateOfSeed).Hours() / 24; elapsed >= 1 {
+		mortalityRate = float64(budidaya.TotalMortality) / float64(budidaya.SeedCount) / elapsed
+	}
+
+	tonase := func(days float64) float64 {
+		survivor := float64(alive) * math.Pow(1-math.Min(mortalityRate, 1), math.Max(days, 0))
+		return math.Round(survivor*curve.HarvestWeight/1000*100) / 100
+	}
+
+	estDate := addDays(date, remaining)
+	estDateMax := addDays(date, remainingMax)
+
+	return &HarvestPredictionOutput{
+		BudidayaID:    budidaya.ID,
+		CurrentWeight: weight,
+		HarvestWeight: curve.HarvestWeight,
+		Alive:         alive,
+		EstDate:       estDate,
+		EstDateMin:    addDays(date, remainingMin),
+		EstDateMax:    estDateMax,
+		EstTonase:     tonase(estDate.Sub(now).Hours() / 24),
+		EstTonaseMin:  tonase(estDateMax.Sub(now).Hours() / 24),
+		EstTonaseMax:  tonase(0),
+	}, nil
+}
+
+func addDays(date time.Time, days float64) time.Time {
+	return date.Add(time.Duration(days * 24 * float64(time.Hour)))
+}
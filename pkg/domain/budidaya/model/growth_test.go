@@ -0,0 +1,68 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/e-fish/api/pkg/domain/budidaya/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPredictHarvest(t *testing.T) {
+	var (
+		now   = time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+		curve = model.GrowthCurve{
+			AsymptoticWeight: 1000,
+			GrowthRate:       0.01,
+			HarvestWeight:    200,
+			GrowthDeviation:  0.1,
+		}
+		budidaya = model.BudidayaOutput{
+			DateOfSeed:     now.AddDate(0, 0, -50),
+			SeedCount:      1000,
+			SeedWeight:     5,
+			TotalMortality: 100,
+		}
+	)
+
+	t.Run("EmptyCurve", func(t *testing.T) {
+		_, err := model.PredictHarvest(budidaya, model.GrowthCurve{}, nil, now)
+		assert.Error(t, err)
+	})
+
+	t.Run("EmptySeed", func(t *testing.T) {
+		_, err := model.PredictHarvest(model.BudidayaOutput{}, curve, nil, now)
+		assert.Error(t, err)
+	})
+
+	t.Run("Seed", func(t *testing.T) {
+		result, err := model.PredictHarvest(budidaya, curve, nil, now)
+		assert.NoError(t, err)
+
+		assert.Equal(t, float64(5), result.CurrentWeight)
+		assert.Equal(t, 900, result.Alive)
+		assert.True(t, result.EstDateMin.Before(result.EstDate))
+		assert.True(t, result.EstDateMax.After(result.EstDate))
+		assert.Equal(t, float64(180), result.EstTonaseMax)
+		assert.True(t, result.EstTonaseMin < result.EstTonase)
+		assert.True(t, result.EstTonase < result.EstTonaseMax)
+	})
+
+	t.Run("Sampling", func(t *testing.T) {
+		seed, _ := model.PredictHarvest(budidaya, curve, nil, now)
+		result, err := model.PredictHarvest(budidaya, curve, &model.SamplingLogOutput{Date: now, AverageWeight: 150}, now)
+		assert.NoError(t, err)
+
+		assert.Equal(t, float64(150), result.CurrentWeight)
+		assert.True(t, result.EstDate.After(now))
+		assert.True(t, result.EstDate.Before(seed.EstDate))
+	})
+
+	t.Run("Ready", func(t *testing.T) {
+		result, err := model.PredictHarvest(budidaya, curve, &model.SamplingLogOutput{Date: now, AverageWeight: 250}, now)
+		assert.NoError(t, err)
+
+		assert.Equal(t, now, result.EstDate)
+		assert.Equal(t, float64(180), result.EstTonase)
+	})
+}
@@ -0,0 +1,52 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/e-fish/api/pkg/domain/budidaya/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateHarvestInput(t *testing.T) {
+	t.Run("Empty", func(t *testing.T) {
+		input := model.CreateHarvestInput{}
+		assert.Error(t, input.Validate())
+	})
+
+	t.Run("GradeNotMatch", func(t *testing.T) {
+		input := model.CreateHarvestInput{
+			BudidayaID:   uuid.New(),
+			Date:         time.Now(),
+			ActualTonase: 100,
+			Grades: []model.HarvestGradeInput{
+				{Grade: "A", Qty: 60},
+				{Grade: "B", Qty: 30},
+			},
+		}
+		assert.Error(t, input.Validate())
+	})
+
+	t.Run("Harvest", func(t *testing.T) {
+		input := model.CreateHarvestInput{
+			BudidayaID:   uuid.New(),
+			Date:         time.Now(),
+			ActualTonase: 100,
+			Grades: []model.HarvestGradeInput{
+				{Grade: "A", Qty: 60},
+				{Grade: "B", Qty: 40},
+			},
+		}
+		assert.NoError(t, input.Validate())
+
+		budidaya := model.BudidayaOutput{ID: input.BudidayaID, PoolID: uuid.New(), Sold: 70}
+		harvest := input.ToHarvest(uuid.New(), budidaya)
+
+		assert.Equal(t, budidaya.PoolID, harvest.PoolID)
+		assert.Equal(t, 70, harvest.Sold)
+		assert.Equal(t, float64(30), harvest.Leftover)
+		assert.Len(t, harvest.Grades, 2)
+		assert.Equal(t, harvest.ID, harvest.Grades[0].HarvestID)
+	})
+}
@@ -0,0 +1,67 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/e-fish/api/pkg/domain/budidaya/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalculateJournal(t *testing.T) {
+	t.Run("WithoutSeed", func(t *testing.T) {
+		budidaya := model.BudidayaOutput{TotalFeed: 10}
+		budidaya.CalculateJournal()
+
+		assert.Equal(t, float64(0), budidaya.SurvivalRate)
+		assert.Equal(t, float64(0), budidaya.FCR)
+	})
+
+	t.Run("Journal", func(t *testing.T) {
+		budidaya := model.BudidayaOutput{
+			SeedCount:      1000,
+			SeedWeight:     10,
+			TotalMortality: 100,
+			AverageWeight:  200,
+			TotalFeed:      255,
+		}
+		budidaya.CalculateJournal()
+
+		assert.Equal(t, 0.9, budidaya.SurvivalRate)
+		assert.Equal(t, float64(180), budidaya.Biomass)
+		assert.InDelta(t, 1.5, budidaya.FCR, 0.0001)
+	})
+
+	t.Run("NoGrowth", func(t *testing.T) {
+		budidaya := model.BudidayaOutput{
+			SeedCount:     1000,
+			SeedWeight:    10,
+			AverageWeight: 5,
+			TotalFeed:     20,
+		}
+		budidaya.CalculateJournal()
+
+		assert.Equal(t, float64(1), budidaya.SurvivalRate)
+		assert.Equal(t, float64(0), budidaya.FCR)
+	})
+}
+
+func TestCreateJournalInputValidate(t *testing.T) {
+	var (
+		budidayaID = uuid.New()
+		today      = time.Now()
+	)
+
+	feeding := model.CreateFeedingLogInput{BudidayaID: budidayaID, Date: today, FeedType: "pelet", Qty: 2.5}
+	assert.NoError(t, feeding.Validate())
+
+	feeding.Qty = 0
+	assert.Error(t, feeding.Validate())
+
+	mortality := model.CreateMortalityLogInput{BudidayaID: budidayaID, Date: today, Count: 0}
+	assert.Error(t, mortality.Validate())
+
+	sampling := model.CreateSamplingLogInput{BudidayaID: budidayaID, Date: today, SampleCount: 10, AverageWeight: 120}
+	assert.NoError(t, sampling.Validate())
+}
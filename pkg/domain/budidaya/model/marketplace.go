@@ -0,0 +1,92 @@
+package model
+
+import (
+	"time"
+
+	"github.com/e-fish/api/pkg/common/helper/werror"
+	"github.com/e-fish/api/pkg/common/infra/orm"
+	errorbudidaya "github.com/e-fish/api/pkg/domain/budidaya/error-budidaya"
+	"github.com/google/uuid"
+)
+
+// sort of the marketplace
+const (
+	SORT_HARVEST_DATE = "harvestDate"
+	SORT_PRICE        = "price"
+	SORT_DISTANCE     = "distance"
+)
+
+// DATE_FORMAT is the format of the harvest date window in the marketplace
+const DATE_FORMAT = "2006-01-02"
+
+var validMarketplaceSort = map[string]bool{
+	SORT_HARVEST_DATE: true,
+	SORT_PRICE:        true,
+	SORT_DISTANCE:     true,
+}
+
+type MarketplaceInput struct {
+	orm.Paginantion
+	FishSpeciesID uuid.UUID
+	ProvinceID    uuid.UUID
+	CityID        uuid.UUID
+	DistrictID    uuid.UUID
+	MinPrice      int
+	MaxPrice      int
+	MinStock      int
+	HarvestFrom   *time.Time
+	HarvestTo     *time.Time
+	// Latitude and Longitude is the location of the buyer, it is required by the distance sort
+	Latitude  *float64
+	Longitude *float64
+}
+
+// Validate check the filter and set the default sort,
+// the default is the nearest harvest date first
+func (m *MarketplaceInput) Validate() error {
+	errs := werror.NewError("failed validate marketplace input")
+
+	if m.Sort == "" {
+		m.Sort = SORT_HARVEST_DATE
+	}
+	if m.Direction == "" {
+		m.Direction = "asc"
+	}
+
+	if !validMarketplaceSort[m.Sort] {
+		errs.Add(errorbudidaya.ErrValidateMarketplaceInput.AttacthDetail(map[string]any{"sort": "must be harvestDate, price or distance"}))
+	}
+	if m.Direction != "asc" && m.Direction != "desc" {
+		errs.Add(errorbudidaya.ErrValidateMarketplaceInput.AttacthDetail(map[string]any{"direction": "must be asc or desc"}))
+	}
+	if m.Sort == SORT_DISTANCE && (m.Latitude == nil || m.Longitude == nil) {
+		errs.Add(errorbudidaya.ErrValidateMarketplaceInput.AttacthDetail(map[string]any{"sort": "latitude and longitude is required by the distance sort"}))
+	}
+	if m.Latitude != nil && (*m.Latitude < -90 || *m.Latitude > 90) {
+		errs.Add(errorbudidaya.ErrValidateMarketplaceInput.AttacthDetail(map[string]any{"latitude": "must be between -90 and 90"}))
+	}
+	if m.Longitude != nil && (*m.Longitude < -180 || *m.Longitude > 180) {
+		errs.Add(errorbudidaya.ErrValidateMarketplaceInput.AttacthDetail(map[string]any{"longitude": "must be between -180 and 180"}))
+	}
+	if m.MinPrice < 0 || m.MaxPrice < 0 {
+		errs.Add(errorbudidaya.ErrValidateMarketplaceInput.AttacthDetail(map[string]any{"price": "can't be negative"}))
+	}
+	if m.MaxPrice > 0 && m.MinPrice > m.MaxPrice {
+		errs.Add(errorbudidaya.ErrValidateMarketplaceInput.AttacthDetail(map[string]any{"minPrice": "must be less than maxPrice"}))
+	}
+	if m.HarvestFrom != nil && m.HarvestTo != nil && m.HarvestFrom.After(*m.HarvestTo) {
+		errs.Add(errorbudidaya.ErrValidateMarketplaceInput.AttacthDetail(map[string]any{"harvestFrom": "must be before harvestTo"}))
+	}
+
+	return errs.Return()
+}
+
+type MarketplaceOutput struct {
+	Limit     int               `json:"limit"`
+	Page      int               `json:"page"`
+	Sort      string            `json:"sort"`
+	Direction string            `json:"direction"`
+	TotalRows int64             `json:"totalRows"`
+	TotalPage int               `json:"totalPage"`
+	Rows      []*BudidayaOutput `json:"rows"`
+}
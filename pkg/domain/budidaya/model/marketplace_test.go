@@ -0,0 +1,41 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/e-fish/api/pkg/domain/budidaya/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarketplaceInput(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		input := model.MarketplaceInput{}
+		assert.NoError(t, input.Validate())
+		assert.Equal(t, model.SORT_HARVEST_DATE, input.Sort)
+		assert.Equal(t, "asc", input.Direction)
+	})
+
+	t.Run("DistanceWithoutCoordinate", func(t *testing.T) {
+		input := model.MarketplaceInput{}
+		input.Sort = model.SORT_DISTANCE
+		assert.Error(t, input.Validate())
+	})
+
+	t.Run("Distance", func(t *testing.T) {
+		lat, lng := -6.2, 106.8
+		input := model.MarketplaceInput{Latitude: &lat, Longitude: &lng}
+		input.Sort = model.SORT_DISTANCE
+		assert.NoError(t, input.Validate())
+	})
+
+	t.Run("InvalidFilter", func(t *testing.T) {
+		var (
+			from = time.Now()
+			to   = from.AddDate(0, 0, -1)
+		)
+		input := model.MarketplaceInput{MinPrice: 20000, MaxPrice: 10000, HarvestFrom: &from, HarvestTo: &to}
+		input.Sort = "name"
+		assert.Error(t, input.Validate())
+	})
+}
@@ -0,0 +1,90 @@
+package model
+
+import (
+	"sort"
+
+	"github.com/e-fish/api/pkg/common/helper/werror"
+	errorbudidaya "github.com/e-fish/api/pkg/domain/budidaya/error-budidaya"
+	"github.com/google/uuid"
+)
+
+// ValidatePricelistTier check the tier set of the budidaya, the limit is the upper bound of the qty of the tier,
+// so the limit must be unique and the tier with the bigger limit can't be more expensive
+func ValidatePricelistTier(pricelist []PriceList) error {
+	var (
+		errs   = werror.NewError("failed validate tier pricelist")
+		sorted = make([]PriceList, len(pricelist))
+	)
+
+	copy(sorted, pricelist)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Limit < sorted[j].Limit
+	})
+
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i].Limit == sorted[i-1].Limit {
+			errs.Add(errorbudidaya.ErrValidatePricelistTier.AttacthDetail(map[string]any{"limit": sorted[i].Limit, "error": "duplicate"}))
+			continue
+		}
+		if sorted[i].Price > sorted[i-1].Price {
+			errs.Add(errorbudidaya.ErrValidatePricelistTier.AttacthDetail(map[string]any{
+				"limit": sorted[i].Limit,
+				"price": sorted[i].Price,
+				"error": "the price can't be more than the price of the smaller limit",
+			}))
+		}
+	}
+
+	return errs.Return()
+}
+
+// MergePricelistTier replace the tier of the set by the new version, the new tier is appended
+func MergePricelistTier(current []*PriceList, replaced []*PriceList) []PriceList {
+	var (
+		tier        = []PriceList{}
+		replacedIDs = map[uuid.UUID]bool{}
+	)
+
+	for _, v := range replaced {
+		if v.PreviousID != nil {
+			replacedIDs[*v.PreviousID] = true
+		}
+	}
+	for _, v := range current {
+		if !replacedIDs[v.ID] {
+			tier = append(tier, *v)
+		}
+	}
+	for _, v := range replaced {
+		tier = append(tier, *v)
+	}
+
+	return tier
+}
+
+type QuoteOutput struct {
+	BudidayaID uuid.UUID        `json:"budidayaID"`
+	Qty        int              `json:"qty"`
+	Pricelist  *PriceListOutput `json:"pricelist"`
+	Price      float64          `json:"price"`
+	Total      float64          `json:"total"`
+	Available  int              `json:"available"`
+}
+
+func NewQuote(budidaya BudidayaOutput, pricelist PriceList, qty int) QuoteOutput {
+	return QuoteOutput{
+		BudidayaID: budidaya.ID,
+		Qty:        qty,
+		Pricelist: &PriceListOutput{
+			ID:            pricelist.ID,
+			BudidayaID:    &pricelist.BudidayaID,
+			Limit:         pricelist.Limit,
+			Price:         pricelist.Price,
+			EffectiveFrom: pricelist.EffectiveFrom,
+			EffectiveTo:   pricelist.EffectiveTo,
+		},
+		Price:     float64(pricelist.Price),
+		Total:     float64(pricelist.Price) * float64(qty),
+		Available: budidaya.Available - qty,
+	}
+}
@@ -0,0 +1,96 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/e-fish/api/pkg/domain/budidaya/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriceListIsEffective(t *testing.T) {
+	var (
+		now       = time.Now()
+		yesterday = now.AddDate(0, 0, -1)
+		tomorrow  = now.AddDate(0, 0, 1)
+	)
+
+	assert.True(t, (&model.PriceList{}).IsEffective(now))
+	assert.True(t, (&model.PriceList{EffectiveFrom: &yesterday}).IsEffective(now))
+	assert.True(t, (&model.PriceList{EffectiveFrom: &yesterday, EffectiveTo: &tomorrow}).IsEffective(now))
+	assert.False(t, (&model.PriceList{EffectiveFrom: &tomorrow}).IsEffective(now))
+	assert.False(t, (&model.PriceList{EffectiveFrom: &yesterday, EffectiveTo: &yesterday}).IsEffective(now))
+}
+
+func TestUpdatePricelistInputToPricelist(t *testing.T) {
+	var (
+		previousID = uuid.New()
+		budidayaID = uuid.New()
+		schedule   = time.Now().AddDate(0, 0, 7)
+	)
+
+	pricelist := model.UpdatePricelistInputToPricelist([]model.UpdatePriceListInput{
+		{ID: previousID, BudidayaID: budidayaID, Limit: 10, Price: 20000},
+		{ID: previousID, BudidayaID: budidayaID, Limit: 10, Price: 25000, EffectiveFrom: &schedule},
+	}, uuid.New())
+
+	assert.Len(t, pricelist, 2)
+	assert.NotEqual(t, previousID, pricelist[0].ID)
+	assert.Equal(t, previousID, *pricelist[0].PreviousID)
+	assert.True(t, pricelist[0].IsEffective(time.Now()))
+	assert.Nil(t, pricelist[0].EffectiveTo)
+	assert.Equal(t, schedule, *pricelist[1].EffectiveFrom)
+	assert.False(t, pricelist[1].IsEffective(time.Now()))
+}
+
+func TestValidatePricelistTier(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		err := model.ValidatePricelistTier([]model.PriceList{
+			{Limit: 100, Price: 18000},
+			{Limit: 10, Price: 20000},
+			{Limit: 50, Price: 20000},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Duplicate", func(t *testing.T) {
+		err := model.ValidatePricelistTier([]model.PriceList{
+			{Limit: 10, Price: 20000},
+			{Limit: 10, Price: 19000},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("MoreExpensive", func(t *testing.T) {
+		err := model.ValidatePricelistTier([]model.PriceList{
+			{Limit: 10, Price: 20000},
+			{Limit: 50, Price: 21000},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("Merge", func(t *testing.T) {
+		var (
+			previousID = uuid.New()
+			current    = []*model.PriceList{
+				{ID: previousID, Limit: 10, Price: 20000},
+				{ID: uuid.New(), Limit: 50, Price: 19000},
+			}
+		)
+
+		tier := model.MergePricelistTier(current, []*model.PriceList{{ID: uuid.New(), Limit: 10, Price: 18000, PreviousID: &previousID}})
+		assert.Len(t, tier, 2)
+		assert.Error(t, model.ValidatePricelistTier(tier))
+	})
+}
+
+func TestNewQuote(t *testing.T) {
+	budidaya := model.BudidayaOutput{ID: uuid.New(), Available: 100}
+	quote := model.NewQuote(budidaya, model.PriceList{ID: uuid.New(), BudidayaID: budidaya.ID, Limit: 50, Price: 20000}, 30)
+
+	assert.Equal(t, float64(20000), quote.Price)
+	assert.Equal(t, float64(600000), quote.Total)
+	assert.Equal(t, 70, quote.Available)
+	assert.Equal(t, 50, quote.Pricelist.Limit)
+}
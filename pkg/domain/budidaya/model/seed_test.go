@@ -0,0 +1,37 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/e-fish/api/pkg/domain/budidaya/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStockingDensity(t *testing.T) {
+	assert.Equal(t, float64(50), model.StockingDensity(1000, 5, 4))
+	assert.Equal(t, float64(0), model.StockingDensity(1000, 0, 4))
+}
+
+func TestCreateBudidayaInput(t *testing.T) {
+	input := model.CreateBudidayaInput{
+		PoolID:        uuid.New(),
+		DateOfSeed:    time.Now(),
+		FishSpeciesID: uuid.New(),
+		SeedCount:     1000,
+		SeedWeight:    5,
+		SeedAge:       21,
+		SeedSize:      3,
+		SeedSupplier:  "BBI Sukabumi",
+	}
+	assert.NoError(t, input.Validate())
+
+	budidaya := input.ToBudidaya(uuid.New(), uuid.New())
+	assert.Equal(t, 21, budidaya.SeedAge)
+	assert.Equal(t, float64(3), budidaya.SeedSize)
+	assert.Equal(t, "BBI Sukabumi", budidaya.SeedSupplier)
+
+	input.SeedCount = 0
+	assert.Error(t, input.Validate())
+}
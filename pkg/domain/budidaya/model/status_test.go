@@ -0,0 +1,27 @@
+package model_test
+
+import (
+	"testing"
+
+	userModel "github.com/e-fish/api/pkg/domain/auth/model"
+	"github.com/e-fish/api/pkg/domain/budidaya/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanUpdateStatus(t *testing.T) {
+	assert.True(t, model.CanUpdateStatus(userModel.SELLER, model.BUDIDAYA, model.PANEN))
+	assert.True(t, model.CanUpdateStatus(userModel.SELLER, model.PANEN, model.FAILED))
+	assert.True(t, model.CanUpdateStatus(userModel.ADMIN, model.BUDIDAYA, model.FAILED))
+
+	assert.False(t, model.CanUpdateStatus(userModel.SELLER, model.BUDIDAYA, model.END))
+	assert.False(t, model.CanUpdateStatus(userModel.SELLER, model.PANEN, model.END))
+	assert.False(t, model.CanUpdateStatus(userModel.SELLER, model.END, model.BUDIDAYA))
+	assert.False(t, model.CanUpdateStatus(userModel.SELLER, model.FAILED, model.PANEN))
+	assert.False(t, model.CanUpdateStatus(userModel.BUYER, model.BUDIDAYA, model.PANEN))
+}
+
+func TestIsClosedStatus(t *testing.T) {
+	assert.True(t, model.IsClosedStatus(model.END))
+	assert.True(t, model.IsClosedStatus(model.FAILED))
+	assert.False(t, model.IsClosedStatus(model.PANEN))
+}
@@ -0,0 +1,77 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/e-fish/api/pkg/domain/budidaya/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func float(v float64) *float64 {
+	return &v
+}
+
+func TestGenerateWaterQualityAlert(t *testing.T) {
+	var (
+		budidayaID = uuid.New()
+		ranges     = []*model.WaterQualityRangeOutput{
+			{Parameter: model.PH, Min: float(6.5), Max: float(8.5)},
+			{Parameter: model.DISSOLVED_OXYGEN, Min: float(4)},
+			{Parameter: model.AMMONIA, Max: float(0.05)},
+			{Parameter: model.SALINITY, Max: float(5)},
+		}
+	)
+
+	reading := model.WaterQuality{
+		ID:              uuid.New(),
+		PoolID:          uuid.New(),
+		BudidayaID:      &budidayaID,
+		PH:              float(9.2),
+		DissolvedOxygen: float(5),
+		Ammonia:         float(0.1),
+	}
+
+	alerts := model.GenerateWaterQualityAlert(reading, ranges, uuid.New())
+
+	assert.Len(t, alerts, 2)
+	assert.Equal(t, model.PH, alerts[0].Parameter)
+	assert.Equal(t, 9.2, alerts[0].Value)
+	assert.Equal(t, reading.ID, alerts[0].WaterQualityID)
+	assert.Equal(t, model.AMMONIA, alerts[1].Parameter)
+
+	assert.Equal(t, "ph 9.20 is out of the safe range (6.50 - 8.50)", alerts[0].ToWaterQualityAlertOutput().Message())
+	assert.Equal(t, "ammonia 0.10 is out of the safe range (- - 0.05)", alerts[1].ToWaterQualityAlertOutput().Message())
+
+	assert.Empty(t, model.GenerateWaterQualityAlert(reading, nil, uuid.New()))
+}
+
+func TestWaterQualityInputValidate(t *testing.T) {
+	input := model.CreateMultipleWaterQualityInput{
+		Input: []model.CreateWaterQualityInput{
+			{PoolID: uuid.New(), MeasuredAt: time.Now(), PH: float(7)},
+		},
+	}
+	assert.NoError(t, input.Validate())
+
+	input.Input = append(input.Input, model.CreateWaterQualityInput{PoolID: uuid.New(), MeasuredAt: time.Now()})
+	assert.Error(t, input.Validate())
+
+	input.Input[1].PH = float(15)
+	assert.Error(t, input.Validate())
+
+	ranges := model.UpdateWaterQualityRangeInput{
+		FishSpeciesID: uuid.New(),
+		Input: []model.WaterQualityRangeInput{
+			{Parameter: model.PH, Min: float(6.5), Max: float(8.5)},
+		},
+	}
+	assert.NoError(t, ranges.Validate())
+
+	ranges.Input = append(ranges.Input, model.WaterQualityRangeInput{Parameter: model.PH, Min: float(7)})
+	assert.Error(t, ranges.Validate())
+
+	ranges.Input = []model.WaterQualityRangeInput{{Parameter: model.TEMPERATURE, Min: float(30), Max: float(25)}}
+	assert.Error(t, ranges.Validate())
+}
@@ -3,20 +3,26 @@ package budidaya
 import (
 	"context"
 	"errors"
+	"sort"
 	"time"
 
 	"github.com/e-fish/api/pkg/common/helper/ctxutil"
+	"github.com/e-fish/api/pkg/common/helper/geo"
+	"github.com/e-fish/api/pkg/common/infra/orm"
 	usertype "github.com/e-fish/api/pkg/domain/auth/model"
 	errorbudidaya "github.com/e-fish/api/pkg/domain/budidaya/error-budidaya"
 	"github.com/e-fish/api/pkg/domain/budidaya/model"
+	"github.com/e-fish/api/pkg/domain/pond"
+	status "github.com/e-fish/api/pkg/domain/pond/model"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
-func newQuery(db *gorm.DB) Query {
+func newQuery(db *gorm.DB, pondRepo pond.Repo) Query {
 	return &query{
-		db: db,
+		db:        db,
+		pondQuery: pondRepo.NewQuery(),
 	}
 }
 
@@ -24,11 +30,29 @@ func newQuery(db *gorm.DB) Query {
 // lock table row to avoid race condition
 func (q *query) lock() Query {
 	db := q.db.Clauses(clause.Locking{Strength: "UPDATE"})
-	return &query{db: db}
+	return &query{db: db, pondQuery: q.pondQuery}
+}
+
+// checkPondSeller implements Query.
+// the pond of the token is checked on every request, so the member that is removed from the team
+// or the member without the permission of the action can't use the pond although the token is not expired
+func (q *query) checkPondSeller(ctx context.Context, action string) error {
+	var (
+		pondID, _  = ctxutil.GetPondID(ctx)
+		appType, _ = ctxutil.GetUserAppType(ctx)
+	)
+
+	if appType != usertype.SELLER {
+		return nil
+	}
+
+	_, err := q.pondQuery.CheckPondPermission(ctx, pondID, action)
+	return err
 }
 
 type query struct {
-	db *gorm.DB
+	db        *gorm.DB
+	pondQuery pond.Query
 }
 
 // ReadBudidayaByID implements Query.
@@ -45,11 +69,107 @@ func (q *query) ReadBudidayaByID(ctx context.Context, id uuid.UUID) (*model.Budi
 	return &budidaya, nil
 }
 
+// EffectivePricelist filter the version of the pricelist that is used at the time,
+// the pricelist before the versioning has no effective date
+func EffectivePricelist(at time.Time) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("price_lists.deleted_at IS NULL AND (price_lists.effective_from IS NULL OR price_lists.effective_from <= ?) AND (price_lists.effective_to IS NULL OR price_lists.effective_to > ?)", at, at)
+	}
+}
+
+// ReadPricelistByID implements Query.
+func (q *query) ReadPricelistByID(ctx context.Context, id uuid.UUID) (*model.PriceList, error) {
+	pricelist := model.PriceList{}
+
+	err := q.db.Where("deleted_at IS NULL and id = ?", id).Take(&pricelist).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorbudidaya.ErrFoundPricelist.AttacthDetail(map[string]any{"id": id})
+		}
+		return nil, errorbudidaya.ErrReadPricelistData.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return &pricelist, nil
+}
+
+// ReadPricelistHistoryByBudidayaID implements Query.
+// all the version of the pricelist include the scheduled version, the seller can only read the budidaya of his pond
+func (q *query) ReadPricelistHistoryByBudidayaID(ctx context.Context, budidayaID uuid.UUID) ([]*model.PriceListOutput, error) {
+	var (
+		pondID, _  = ctxutil.GetPondID(ctx)
+		appType, _ = ctxutil.GetUserAppType(ctx)
+		data       = []*model.PriceListOutput{}
+	)
+
+	err := q.checkPondSeller(ctx, status.TEAM_VIEW)
+	if err != nil {
+		return nil, err
+	}
+
+	budidaya, err := q.ReadBudidayaByID(ctx, budidayaID)
+	if err != nil {
+		return nil, err
+	}
+
+	if appType == usertype.SELLER && budidaya.PondID != pondID {
+		return nil, errorbudidaya.ErrAccessBudidaya.AttacthDetail(map[string]any{"id": budidayaID})
+	}
+
+	err = q.db.Where("deleted_at IS NULL and budidaya_id = ?", budidayaID).Order("price_lists.limit, effective_from").Find(&data).Error
+	if err != nil {
+		return nil, errorbudidaya.ErrReadPricelistData.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return data, nil
+}
+
+// ReadPricelistLatestByBudidayaID implements Query.
+// the latest version of each tier, include the version that is scheduled
+func (q *query) ReadPricelistLatestByBudidayaID(ctx context.Context, budidayaID uuid.UUID) ([]*model.PriceList, error) {
+	data := []*model.PriceList{}
+
+	err := q.db.Where("deleted_at IS NULL and budidaya_id = ? and effective_to IS NULL", budidayaID).Find(&data).Error
+	if err != nil {
+		return nil, errorbudidaya.ErrReadPricelistData.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return data, nil
+}
+
+// ReadQuote implements Query.
+// the tier is chosen the same as the order, so the quote is the price of the order when it is created
+func (q *query) ReadQuote(ctx context.Context, input model.ReadPricelistBudidayaInput) (*model.QuoteOutput, error) {
+	if input.Qty < 1 {
+		return nil, errorbudidaya.ErrValidateInputQuote.AttacthDetail(map[string]any{"qty": "must be more than 0"})
+	}
+
+	budidaya, err := q.ReadBudidayaByID(ctx, input.BudidayaID)
+	if err != nil {
+		return nil, err
+	}
+
+	if model.IsClosedStatus(budidaya.Status) {
+		return nil, errorbudidaya.ErrBudidayaNotActive.AttacthDetail(map[string]any{"id": input.BudidayaID, "status": budidaya.Status})
+	}
+
+	if input.Qty > budidaya.Available {
+		return nil, errorbudidaya.ErrQuoteStockNotEnough.AttacthDetail(map[string]any{"qty": input.Qty, "available": budidaya.Available})
+	}
+
+	pricelist, err := q.ReadPriceListBudidayaByBiggerThanLimitAndBudidayaID(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	quote := model.NewQuote(*budidaya, *pricelist, input.Qty)
+	return &quote, nil
+}
+
 // ReadPriceListBudidayaByBiggerThanLimitAndBudidayaID implements Query.
 func (q *query) ReadPriceListBudidayaByBiggerThanLimitAndBudidayaID(ctx context.Context, input model.ReadPricelistBudidayaInput) (*model.PriceList, error) {
 	pricelist := model.PriceList{}
 
-	err := q.db.Where("deleted_at IS NULL and budidaya_id = ? AND price_lists.limit >= ?", input.BudidayaID, input.Qty).Order("price_lists.limit asc").Preload("Budidaya").Take(&pricelist).Error
+	err := q.db.Scopes(EffectivePricelist(time.Now())).Where("budidaya_id = ? AND price_lists.limit >= ?", input.BudidayaID, input.Qty).Order("price_lists.limit asc").Preload("Budidaya").Take(&pricelist).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return q.ReadPriceListBudidayaBySmallerThanLimitAndBudidayaID(ctx, input)
@@ -63,14 +183,14 @@ func (q *query) ReadPriceListBudidayaByBiggerThanLimitAndBudidayaID(ctx context.
 func (q *query) ReadPriceListBudidayaBySmallerThanLimitAndBudidayaID(ctx context.Context, input model.ReadPricelistBudidayaInput) (*model.PriceList, error) {
 	pricelist := model.PriceList{}
 
-	err := q.db.Where("deleted_at IS NULL and budidaya_id = ? AND price_lists.limit <= ?", input.BudidayaID, input.Qty).Order("price_lists.limit desc").Preload("Budidaya").Take(&pricelist).Error
+	err := q.db.Scopes(EffectivePricelist(time.Now())).Where("budidaya_id = ? AND price_lists.limit <= ?", input.BudidayaID, input.Qty).Order("price_lists.limit desc").Preload("Budidaya").Take(&pricelist).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return q.ReadPriceListBudidayaBySmallerThanLimitAndBudidayaID(ctx, input)
+			return nil, errorbudidaya.ErrFoundPricelist.AttacthDetail(map[string]any{
+				"input": input,
+			})
 		}
-		return nil, errorbudidaya.ErrFoundPricelist.AttacthDetail(map[string]any{
-			"input": input,
-		})
+		return nil, errorbudidaya.ErrReadPricelistData.AttacthDetail(map[string]any{"error": err})
 	}
 	return &pricelist, nil
 }
@@ -85,7 +205,7 @@ func (q *query) ReadBudidayaCodeActive(ctx context.Context) (*string, error) {
 
 	db = db.Order("created_at DESC")
 
-	err := db.Where("deleted_at IS NULL and pond_id = ? and status <> ?", pondID, model.END).First(&budidaya).Error
+	err := db.Where("deleted_at IS NULL and pond_id = ? and status NOT IN ?", pondID, model.ClosedStatus).First(&budidaya).Error
 	if err != nil {
 		if !errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errorbudidaya.ErrReadBudidayaCode.AttacthDetail(map[string]any{"error": err})
@@ -96,7 +216,7 @@ func (q *query) ReadBudidayaCodeActive(ctx context.Context) (*string, error) {
 		return &budidaya.Code, nil
 	}
 
-	err = db.Where("deleted_at IS NULL and pond_id = ? and status = ?", pondID, model.END).First(&budidaya).Error
+	err = db.Where("deleted_at IS NULL and pond_id = ? and status IN ?", pondID, model.ClosedStatus).First(&budidaya).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errorbudidaya.ErrFoundBudidayaCode
@@ -130,8 +250,8 @@ func (q *query) ReadBudidayaByUserAdmin(ctx context.Context, input model.GetBudi
 	)
 
 	db = db.Preload("Pool")
-	db = db.Preload("FishSpecies").Preload("PriceList")
-	err := db.Where("deleted_at IS NULL and status <> ? and pond_id = ?", model.END, input.PondID).Find(&res).Error
+	db = db.Preload("FishSpecies").Preload("PriceList", EffectivePricelist(time.Now()))
+	err := db.Where("deleted_at IS NULL and status NOT IN ? and pond_id = ?", model.ClosedStatus, input.PondID).Find(&res).Error
 	if err != nil {
 		return nil, err
 	}
@@ -140,7 +260,9 @@ func (q *query) ReadBudidayaByUserAdmin(ctx context.Context, input model.GetBudi
 }
 
 // ReadBudidayaNeaerest implements Query.
-func (q *query) ReadBudidayaNeaerest(ctx context.Context) ([]*model.BudidayaOutput, error) {
+// when the coordinate is given the budidaya is sorted by the distance to the pond,
+// the radius is prefiltered by the bounding box and then filtered by the exact distance
+func (q *query) ReadBudidayaNeaerest(ctx context.Context, nearby geo.Nearby) ([]*model.BudidayaOutput, error) {
 	var (
 		res   = []*model.BudidayaOutput{}
 		db    = q.db
@@ -148,15 +270,48 @@ func (q *query) ReadBudidayaNeaerest(ctx context.Context) ([]*model.BudidayaOutp
 		today = time.Date(now.Year(), now.Month(), now.Day(), 1, 0, 0, 0, now.Location())
 	)
 
+	err := nearby.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	if !nearby.IsEmpty() && nearby.RadiusKm > 0 {
+		box := geo.NewBoundingBox(*nearby.Point, nearby.RadiusKm)
+		db = db.Joins("JOIN ponds ON ponds.id = budidayas.pond_id").
+			Where("ponds.latitude BETWEEN ? AND ? AND ponds.longitude BETWEEN ? AND ?", box.MinLatitude, box.MaxLatitude, box.MinLongitude, box.MaxLongitude).
+			Select("budidayas.*")
+	}
+
 	db = db.Preload("Pool")
-	db = db.Preload("FishSpecies").Preload("PriceList").Preload("Pond")
-	db = db.Where("est_panen_date IS NULL OR est_panen_date >= ?", today)
-	err := db.Where("deleted_at IS NULL and status <> ?", model.END).Find(&res).Error
+	db = db.Preload("FishSpecies").Preload("PriceList", EffectivePricelist(time.Now())).Preload("Pond")
+	db = db.Where("budidayas.est_panen_date IS NULL OR budidayas.est_panen_date >= ?", today)
+	err = db.Where("budidayas.deleted_at IS NULL and budidayas.status NOT IN ?", model.ClosedStatus).Find(&res).Error
 	if err != nil {
 		return nil, err
 	}
 
-	return res, nil
+	if nearby.IsEmpty() {
+		return res, nil
+	}
+
+	nearest := []*model.BudidayaOutput{}
+	for _, v := range res {
+		if v.Pond == nil {
+			continue
+		}
+		distance, ok := nearby.Distance(geo.Point{Latitude: v.Pond.Latitude, Longitude: v.Pond.Longitude})
+		if !ok {
+			continue
+		}
+		v.Distance = &distance
+		nearest = append(nearest, v)
+	}
+
+	sort.SliceStable(nearest, func(i, j int) bool {
+		return *nearest[i].Distance < *nearest[j].Distance
+	})
+
+	return nearest, nil
 }
 
 // ReadBudidayaByUserBuyer implements Query.
@@ -169,9 +324,9 @@ func (q *query) ReadBudidayaByUserBuyer(ctx context.Context, input model.GetBudi
 	)
 
 	db = db.Preload("Pool")
-	db = db.Preload("FishSpecies").Preload("PriceList")
+	db = db.Preload("FishSpecies").Preload("PriceList", EffectivePricelist(time.Now()))
 	db = db.Where("est_panen_date IS NULL OR est_panen_date >= ?", today)
-	err := db.Where("deleted_at IS NULL and status <> ? and pond_id = ?", model.END, input.PondID).Find(&res).Error
+	err := db.Where("deleted_at IS NULL and status NOT IN ? and pond_id = ?", model.ClosedStatus, input.PondID).Find(&res).Error
 	if err != nil {
 		return nil, err
 	}
@@ -187,8 +342,13 @@ func (q *query) ReadBudidayaByUserSeller(ctx context.Context) ([]*model.Budidaya
 		db        = q.db
 	)
 
-	db = db.Preload("Pool").Preload("FishSpecies").Preload("PriceList")
-	err := db.Where("deleted_at IS NULL and pond_id = ? and status <> ?", pondID, model.END).Find(&res).Error
+	err := q.checkPondSeller(ctx, status.TEAM_VIEW)
+	if err != nil {
+		return nil, err
+	}
+
+	db = db.Preload("Pool").Preload("FishSpecies").Preload("PriceList", EffectivePricelist(time.Now()))
+	err = db.Where("deleted_at IS NULL and pond_id = ? and status NOT IN ?", pondID, model.ClosedStatus).Find(&res).Error
 	if err != nil {
 		return nil, err
 	}
@@ -202,7 +362,7 @@ func (q *query) ReadBudidayaActiveByPoolID(ctx context.Context, input uuid.UUID)
 		res = model.BudidayaOutput{}
 	)
 
-	err := q.db.Where("deleted_at IS NULL and pool_id = ? and status <> ?", input, model.END).Take(&res).Error
+	err := q.db.Where("deleted_at IS NULL and pool_id = ? and status NOT IN ?", input, model.ClosedStatus).Take(&res).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errorbudidaya.ErrFoundBudidaya.AttacthDetail(map[string]any{"poolID": input})
@@ -219,10 +379,301 @@ func (q *query) ReadAllDataFishSpecies(ctx context.Context) ([]*model.FishSpecie
 		userID, _ = ctxutil.GetUserID(ctx)
 	)
 
-	err := q.db.Debug().Where("deleted_at IS NULL and created_by = ?", userID).Find(&res).Error
+	err := q.db.Debug().Where("deleted_at IS NULL and created_by = ?", userID).Preload("WaterQualityRange", "deleted_at IS NULL").Find(&res).Error
 	if err != nil {
 		return nil, errorbudidaya.ErrFailedReadBudidaya.AttacthDetail(map[string]any{"error": err})
 	}
 
 	return res, nil
 }
+
+// ReadJournalByBudidayaID implements Query.
+// seller can only read the journal of his pond
+func (q *query) ReadJournalByBudidayaID(ctx context.Context, budidayaID uuid.UUID) (*model.JournalOutput, error) {
+	var (
+		pondID, _  = ctxutil.GetPondID(ctx)
+		appType, _ = ctxutil.GetUserAppType(ctx)
+		data       = model.JournalOutput{
+			Feeding:   []*model.FeedingLogOutput{},
+			Mortality: []*model.MortalityLogOutput{},
+			Sampling:  []*model.SamplingLogOutput{},
+		}
+		where = "deleted_at IS NULL and budidaya_id = ?"
+	)
+
+	err := q.checkPondSeller(ctx, status.TEAM_VIEW)
+	if err != nil {
+		return nil, err
+	}
+
+	budidaya, err := q.ReadBudidayaByID(ctx, budidayaID)
+	if err != nil {
+		return nil, err
+	}
+
+	if appType == usertype.SELLER && budidaya.PondID != pondID {
+		return nil, errorbudidaya.ErrAccessBudidaya.AttacthDetail(map[string]any{"id": budidayaID})
+	}
+
+	err = q.db.Where(where, budidayaID).Order("date DESC").Find(&data.Feeding).Error
+	if err != nil {
+		return nil, errorbudidaya.ErrReadJournalData.AttacthDetail(map[string]any{"error": err, "journal": "feeding"})
+	}
+
+	err = q.db.Where(where, budidayaID).Order("date DESC").Find(&data.Mortality).Error
+	if err != nil {
+		return nil, errorbudidaya.ErrReadJournalData.AttacthDetail(map[string]any{"error": err, "journal": "mortality"})
+	}
+
+	err = q.db.Where(where, budidayaID).Order("date DESC").Find(&data.Sampling).Error
+	if err != nil {
+		return nil, errorbudidaya.ErrReadJournalData.AttacthDetail(map[string]any{"error": err, "journal": "sampling"})
+	}
+
+	return &data, nil
+}
+
+// ReadLastSamplingLog implements Query.
+func (q *query) ReadLastSamplingLog(ctx context.Context, budidayaID uuid.UUID) (*model.SamplingLogOutput, error) {
+	data := model.SamplingLogOutput{}
+
+	err := q.db.Where("deleted_at IS NULL and budidaya_id = ?", budidayaID).Order("date DESC, created_at DESC").Take(&data).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorbudidaya.ErrFoundSamplingLog.AttacthDetail(map[string]any{"budidayaID": budidayaID})
+		}
+		return nil, errorbudidaya.ErrReadJournalData.AttacthDetail(map[string]any{"error": err, "journal": "sampling"})
+	}
+
+	return &data, nil
+}
+
+// ReadPoolByID implements Query.
+// the pool is read by the budidaya so it can be locked in the transaction of the budidaya
+func (q *query) ReadPoolByID(ctx context.Context, id uuid.UUID) (*status.PoolOutput, error) {
+	data := status.PoolOutput{}
+
+	err := q.db.Where("deleted_at IS NULL and id = ?", id).Take(&data).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorbudidaya.ErrFoundPool.AttacthDetail(map[string]any{"poolID": id})
+		}
+		return nil, errorbudidaya.ErrFailedReadBudidaya.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return &data, nil
+}
+
+// ReadFishSpeciesByID implements Query.
+func (q *query) ReadFishSpeciesByID(ctx context.Context, id uuid.UUID) (*model.FishSpeciesOutput, error) {
+	data := model.FishSpeciesOutput{}
+
+	err := q.db.Where("deleted_at IS NULL and id = ?", id).Take(&data).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorbudidaya.ErrFoundFishSpecies.AttacthDetail(map[string]any{"id": id})
+		}
+		return nil, errorbudidaya.ErrFailedReadBudidaya.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return &data, nil
+}
+
+// ReadWaterQualityRangeByFishSpeciesID implements Query.
+func (q *query) ReadWaterQualityRangeByFishSpeciesID(ctx context.Context, fishSpeciesID uuid.UUID) ([]*model.WaterQualityRangeOutput, error) {
+	data := []*model.WaterQualityRangeOutput{}
+
+	err := q.db.Where("deleted_at IS NULL and fish_species_id = ?", fishSpeciesID).Find(&data).Error
+	if err != nil {
+		return nil, errorbudidaya.ErrReadWaterQualityData.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return data, nil
+}
+
+// ReadWaterQualityByPoolID implements Query.
+// the default range is the last 7 days, seller can only read the pool of his pond
+func (q *query) ReadWaterQualityByPoolID(ctx context.Context, input model.ReadWaterQualityInput) ([]*model.WaterQualityOutput, error) {
+	var (
+		pondID, _  = ctxutil.GetPondID(ctx)
+		appType, _ = ctxutil.GetUserAppType(ctx)
+		data       = []*model.WaterQualityOutput{}
+		db         = q.db
+	)
+
+	err := q.checkPondSeller(ctx, status.TEAM_VIEW)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.EndDate.IsZero() {
+		input.EndDate = time.Now()
+	}
+	if input.StartDate.IsZero() {
+		input.StartDate = input.EndDate.AddDate(0, 0, -7)
+	}
+
+	// the sensor history is only read by the seller of the pond and the admin
+	switch appType {
+	case usertype.SELLER:
+		db = db.Where("pond_id = ?", pondID)
+	case usertype.ADMIN:
+	default:
+		return nil, errorbudidaya.ErrAccessWaterQuality.AttacthDetail(map[string]any{"appType": appType})
+	}
+
+	err = db.Where("deleted_at IS NULL and pool_id = ? and measured_at >= ? and measured_at <= ?", input.PoolID, input.StartDate, input.EndDate).
+		Preload("Alerts").
+		Order("measured_at DESC").
+		Find(&data).Error
+	if err != nil {
+		return nil, errorbudidaya.ErrReadWaterQualityData.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return data, nil
+}
+
+// ReadHarvestByBudidayaID implements Query.
+func (q *query) ReadHarvestByBudidayaID(ctx context.Context, budidayaID uuid.UUID) (*model.HarvestOutput, error) {
+	data := model.HarvestOutput{}
+
+	err := q.db.Where("deleted_at IS NULL and budidaya_id = ?", budidayaID).Preload("Grades").Take(&data).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorbudidaya.ErrFoundHarvest.AttacthDetail(map[string]any{"budidayaID": budidayaID})
+		}
+		return nil, errorbudidaya.ErrFailedReadBudidaya.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return &data, nil
+}
+
+// ReadHarvestPrediction implements Query.
+func (q *query) ReadHarvestPrediction(ctx context.Context, budidayaID uuid.UUID) (*model.HarvestPredictionOutput, error) {
+	var (
+		pondID, _  = ctxutil.GetPondID(ctx)
+		appType, _ = ctxutil.GetUserAppType(ctx)
+	)
+
+	err := q.checkPondSeller(ctx, status.TEAM_VIEW)
+	if err != nil {
+		return nil, err
+	}
+
+	budidaya, err := q.ReadBudidayaByID(ctx, budidayaID)
+	if err != nil {
+		return nil, err
+	}
+
+	if appType == usertype.SELLER && budidaya.PondID != pondID {
+		return nil, errorbudidaya.ErrAccessBudidaya.AttacthDetail(map[string]any{"id": budidayaID})
+	}
+
+	species, err := q.ReadFishSpeciesByID(ctx, budidaya.FishSpeciesID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the budidaya without sampling is predicted from the seed weight
+	last, err := q.ReadLastSamplingLog(ctx, budidayaID)
+	if err != nil && !errorbudidaya.ErrFoundSamplingLog.Is(err) {
+		return nil, err
+	}
+
+	return model.PredictHarvest(*budidaya, species.GrowthCurve, last, time.Now())
+}
+
+// ReadMarketplace implements Query.
+// the budidaya of the active pond that is still open, the price filter and sort use the effective tier of the pricelist
+func (q *query) ReadMarketplace(ctx context.Context, input model.MarketplaceInput) (*model.MarketplaceOutput, error) {
+	var (
+		res   = []*model.BudidayaOutput{}
+		db    = q.db
+		now   = time.Now()
+		today = time.Date(now.Year(), now.Month(), now.Day(), 1, 0, 0, 0, now.Location())
+		sort  = input.Sort
+	)
+
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	input.ObjectTable = model.Budidaya{}
+
+	db = db.Joins("JOIN ponds ON ponds.id = budidayas.pond_id").
+		Where("budidayas.deleted_at IS NULL and budidayas.status NOT IN ? and ponds.status = ?", model.ClosedStatus, status.ACTIVED)
+
+	if input.FishSpeciesID != uuid.Nil {
+		db = db.Where("budidayas.fish_species_id = ?", input.FishSpeciesID)
+	}
+	if input.ProvinceID != uuid.Nil {
+		db = db.Where("ponds.province_id = ?", input.ProvinceID)
+	}
+	if input.CityID != uuid.Nil {
+		db = db.Where("ponds.city_id = ?", input.CityID)
+	}
+	if input.DistrictID != uuid.Nil {
+		db = db.Where("ponds.district_id = ?", input.DistrictID)
+	}
+	if input.MinStock > 0 {
+		db = db.Where("budidayas.est_tonase - budidayas.sold - budidayas.reserved >= ?", input.MinStock)
+	}
+	if input.MinPrice > 0 || input.MaxPrice > 0 {
+		price := q.db.Table("price_lists").Select("1").Scopes(EffectivePricelist(now)).Where("price_lists.budidaya_id = budidayas.id")
+		if input.MinPrice > 0 {
+			price = price.Where("price_lists.price >= ?", input.MinPrice)
+		}
+		if input.MaxPrice > 0 {
+			price = price.Where("price_lists.price <= ?", input.MaxPrice)
+		}
+		db = db.Where("EXISTS (?)", price)
+	}
+
+	switch {
+	case input.HarvestFrom != nil || input.HarvestTo != nil:
+		if input.HarvestFrom != nil {
+			db = db.Where("budidayas.est_panen_date >= ?", input.HarvestFrom)
+		}
+		if input.HarvestTo != nil {
+			db = db.Where("budidayas.est_panen_date <= ?", input.HarvestTo)
+		}
+	default:
+		db = db.Where("budidayas.est_panen_date IS NULL OR budidayas.est_panen_date >= ?", today)
+	}
+
+	switch input.Sort {
+	case model.SORT_PRICE:
+		input.Sort = "(SELECT MIN(price_lists.price) FROM price_lists WHERE price_lists.budidaya_id = budidayas.id AND price_lists.deleted_at IS NULL AND (price_lists.effective_from IS NULL OR price_lists.effective_from <= CURRENT_TIMESTAMP) AND (price_lists.effective_to IS NULL OR price_lists.effective_to > CURRENT_TIMESTAMP))"
+	case model.SORT_DISTANCE:
+		input.Sort = geo.DistanceSQL(geo.Point{Latitude: *input.Latitude, Longitude: *input.Longitude}, "ponds.latitude", "ponds.longitude")
+	default:
+		input.Sort = "budidayas.est_panen_date"
+	}
+
+	db = db.Preload("Pool").Preload("FishSpecies").Preload("PriceList", EffectivePricelist(now)).Preload("Pond")
+	err = db.Scopes(orm.Paginate(db.Session(&gorm.Session{}), &input.Paginantion)).Select("budidayas.*").Find(&res).Error
+	if err != nil {
+		return nil, errorbudidaya.ErrFailedReadBudidaya.AttacthDetail(map[string]any{"error": err})
+	}
+
+	if input.Latitude != nil && input.Longitude != nil {
+		point := geo.Point{Latitude: *input.Latitude, Longitude: *input.Longitude}
+		for _, v := range res {
+			if v.Pond != nil {
+				distance := geo.Haversine(point, geo.Point{Latitude: v.Pond.Latitude, Longitude: v.Pond.Longitude})
+				v.Distance = &distance
+			}
+		}
+	}
+
+	return &model.MarketplaceOutput{
+		Limit:     input.Limit,
+		Page:      input.Page,
+		Sort:      sort,
+		Direction: input.Direction,
+		TotalRows: input.TotalRows,
+		TotalPage: input.TotalPage,
+		Rows:      res,
+	}, nil
+}
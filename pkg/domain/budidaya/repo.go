@@ -35,5 +35,5 @@ func (a *Budidaya) NewCommand(ctx context.Context) Command {
 
 // NewQuery implements Repo.
 func (a *Budidaya) NewQuery() Query {
-	return newQuery(a.db)
+	return newQuery(a.db, a.pondRepo)
 }
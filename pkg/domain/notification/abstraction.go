@@ -0,0 +1,32 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/e-fish/api/pkg/common/infra/orm"
+	"github.com/e-fish/api/pkg/domain/notification/model"
+	"github.com/google/uuid"
+)
+
+type Repo interface {
+	NewCommand(ctx context.Context) Command
+	NewQuery() Query
+}
+
+type Command interface {
+	CreateNotification(ctx context.Context, input model.CreateNotificationInput) (*uuid.UUID, error)
+	ReadNotification(ctx context.Context, input model.ReadNotificationInput) (*uuid.UUID, error)
+
+	// Push send the push message of the notification that is created by the command,
+	// it is called after the transaction is committed so the message is not sent for the rollback notification
+	Push(ctx context.Context)
+
+	Rollback(ctx context.Context) error
+	Commit(ctx context.Context) error
+}
+
+type Query interface {
+	ReadNotificationByUserID(ctx context.Context, input orm.Paginantion) (*model.ListNotificationOutput, error)
+
+	lock() Query
+}
@@ -0,0 +1,110 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/e-fish/api/pkg/common/helper/ctxutil"
+	"github.com/e-fish/api/pkg/common/infra/firebase"
+	"github.com/e-fish/api/pkg/common/infra/orm"
+	errornotification "github.com/e-fish/api/pkg/domain/notification/error-notification"
+	"github.com/e-fish/api/pkg/domain/notification/model"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+func newCommand(ctx context.Context, db *gorm.DB, messaging firebase.Messaging) Command {
+	var (
+		dbTxn = orm.BeginTxn(ctx, db)
+	)
+
+	return &command{
+		dbTxn:     dbTxn.WithContext(ctx),
+		query:     newQuery(dbTxn),
+		messaging: messaging,
+	}
+}
+
+type command struct {
+	dbTxn     *gorm.DB
+	query     Query
+	messaging firebase.Messaging
+	pending   []firebase.FirebaseMessageData
+}
+
+// CreateNotification implements Command.
+func (c *command) CreateNotification(ctx context.Context, input model.CreateNotificationInput) (*uuid.UUID, error) {
+	var (
+		userID, _ = ctxutil.GetUserID(ctx)
+	)
+
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	notification := input.ToNotification(userID)
+
+	err = c.dbTxn.Create(&notification).Error
+	if err != nil {
+		return nil, errornotification.ErrCreateNotification.AttacthDetail(map[string]any{"error": err})
+	}
+
+	c.pending = append(c.pending, notification.ToMessage())
+
+	return &notification.ID, nil
+}
+
+// ReadNotification implements Command.
+func (c *command) ReadNotification(ctx context.Context, input model.ReadNotificationInput) (*uuid.UUID, error) {
+	var (
+		userID, _ = ctxutil.GetUserID(ctx)
+		now       = time.Now()
+		db        = c.dbTxn.Model(&model.Notification{}).Where("deleted_at IS NULL and read_at IS NULL and user_id = ?", userID)
+	)
+
+	if input.ID != uuid.Nil {
+		db = db.Where("id = ?", input.ID)
+	}
+
+	result := db.Updates(map[string]any{
+		"read_at":    now,
+		"updated_at": now,
+		"updated_by": userID,
+	})
+	if result.Error != nil {
+		return nil, errornotification.ErrReadNotification.AttacthDetail(map[string]any{"error": result.Error})
+	}
+	if input.ID != uuid.Nil && result.RowsAffected == 0 {
+		return nil, errornotification.ErrFoundNotification.AttacthDetail(map[string]any{"id": input.ID})
+	}
+
+	return &input.ID, nil
+}
+
+// Push implements Command.
+func (c *command) Push(ctx context.Context) {
+	if c.messaging != nil {
+		for _, v := range c.pending {
+			c.messaging.SendMessage(ctx, v)
+		}
+	}
+	c.pending = nil
+}
+
+// Commit implements Command.
+func (c *command) Commit(ctx context.Context) error {
+	if err := orm.CommitTxn(ctx); err != nil {
+		return errornotification.ErrCommit.AttacthDetail(map[string]any{"errors": err})
+	}
+	return nil
+}
+
+// Rollback implements Command.
+func (c *command) Rollback(ctx context.Context) error {
+	c.pending = nil
+	if err := orm.RollbackTxn(ctx); err != nil {
+		return errornotification.ErrRollback.AttacthDetail(map[string]any{"errors": err})
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package errornotification
+
+import "github.com/e-fish/api/pkg/common/helper/werror"
+
+var (
+	ErrCommit = werror.Error{
+		Code:    "FailedCommitTransaction",
+		Message: "can't commit transaction notification",
+	}
+	ErrRollback = werror.Error{
+		Code:    "FailedRollbackTransaction",
+		Message: "can't rollback transaction notification",
+	}
+
+	ErrValidateInputNotification = werror.Error{
+		Code:    "ValidatedFailedInputNotification",
+		Message: "field can't be empty",
+	}
+	ErrCreateNotification = werror.Error{
+		Code:    "FailedCreateNotification",
+		Message: "failed create notification",
+	}
+	ErrReadNotification = werror.Error{
+		Code:    "FailedReadNotification",
+		Message: "failed update read notification",
+	}
+	ErrFoundNotification = werror.Error{
+		Code:    "ValidatedFailedFoundNotification",
+		Message: "notification not found",
+	}
+	ErrFailedFindNotification = werror.Error{
+		Code:    "FailedFindNotification",
+		Message: "failed find notification",
+	}
+)
@@ -0,0 +1,115 @@
+package model
+
+import (
+	"time"
+
+	"github.com/e-fish/api/pkg/common/helper/werror"
+	"github.com/e-fish/api/pkg/common/infra/firebase"
+	"github.com/e-fish/api/pkg/common/infra/orm"
+	errornotification "github.com/e-fish/api/pkg/domain/notification/error-notification"
+	"github.com/google/uuid"
+)
+
+// type of the notification, it is also sent in the data of the push message
+const (
+	POND_STATUS     = "pond-status"
+	TEAM_INVITATION = "team-invitation"
+)
+
+type Notification struct {
+	ID     uuid.UUID         `gorm:"primaryKey,size:256"`
+	UserID uuid.UUID         `gorm:"size:256"`
+	Type   string            `gorm:"size:64"`
+	Title  string            `gorm:"size:256"`
+	Body   string            `gorm:"size:1024"`
+	Data   map[string]string `gorm:"serializer:json"`
+	ReadAt *time.Time
+	orm.OrmModel
+}
+
+type CreateNotificationInput struct {
+	UserID uuid.UUID
+	Type   string
+	Title  string
+	Body   string
+	Data   map[string]string
+}
+
+func (c *CreateNotificationInput) Validate() error {
+	errs := werror.NewError("error validate input")
+
+	if c.UserID == uuid.Nil {
+		errs.Add(errornotification.ErrValidateInputNotification.AttacthDetail(map[string]any{"userID": "empty"}))
+	}
+	if c.Type == "" {
+		errs.Add(errornotification.ErrValidateInputNotification.AttacthDetail(map[string]any{"type": "empty"}))
+	}
+	if c.Title == "" {
+		errs.Add(errornotification.ErrValidateInputNotification.AttacthDetail(map[string]any{"title": "empty"}))
+	}
+
+	return errs.Return()
+}
+
+func (c *CreateNotificationInput) ToNotification(createdBy uuid.UUID) Notification {
+	return Notification{
+		ID:     uuid.New(),
+		UserID: c.UserID,
+		Type:   c.Type,
+		Title:  c.Title,
+		Body:   c.Body,
+		Data:   c.Data,
+		OrmModel: orm.OrmModel{
+			CreatedAt: time.Now(),
+			CreatedBy: createdBy,
+		},
+	}
+}
+
+// ToMessage is the push message of the notification, the topic is the user id of the receiver
+func (n *Notification) ToMessage() firebase.FirebaseMessageData {
+	data := map[string]string{
+		"type":           n.Type,
+		"notificationID": n.ID.String(),
+	}
+	for k, v := range n.Data {
+		data[k] = v
+	}
+
+	return firebase.FirebaseMessageData{
+		Title: n.Title,
+		Body:  n.Body,
+		Tag:   n.Type,
+		Data:  data,
+		Topic: n.UserID.String(),
+	}
+}
+
+type ReadNotificationInput struct {
+	// ID is the notification that is read, all the notification of the user is read when it is empty
+	ID uuid.UUID `json:"id"`
+}
+
+type NotificationOutput struct {
+	ID        uuid.UUID         `gorm:"size:256" json:"id"`
+	UserID    uuid.UUID         `gorm:"size:256" json:"userID"`
+	Type      string            `json:"type"`
+	Title     string            `json:"title"`
+	Body      string            `json:"body"`
+	Data      map[string]string `gorm:"serializer:json" json:"data,omitempty"`
+	ReadAt    *time.Time        `json:"readAt"`
+	CreatedAt time.Time         `json:"createdAt"`
+}
+
+func (n *NotificationOutput) TableName() string {
+	return "notifications"
+}
+
+type ListNotificationOutput struct {
+	Limit       int                   `json:"limit"`
+	Page        int                   `json:"page"`
+	TotalRows   int64                 `json:"totalRows"`
+	TotalPage   int                   `json:"totalPage"`
+	TotalUnread int64                 `json:"totalUnread"`
+	Rows        []*NotificationOutput `json:"rows"`
+}
@@ -0,0 +1,32 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/e-fish/api/pkg/domain/notification/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateNotificationInput(t *testing.T) {
+	input := model.CreateNotificationInput{}
+	assert.Error(t, input.Validate())
+
+	input = model.CreateNotificationInput{
+		UserID: uuid.New(),
+		Type:   model.POND_STATUS,
+		Title:  "Pond approved",
+		Body:   "the status of Kolam Sejahtera is aktif",
+		Data:   map[string]string{"pondID": "1"},
+	}
+	assert.NoError(t, input.Validate())
+
+	notification := input.ToNotification(uuid.New())
+	message := notification.ToMessage()
+
+	assert.Equal(t, input.UserID.String(), message.Topic)
+	assert.Equal(t, "Pond approved", message.Title)
+	assert.Equal(t, model.POND_STATUS, message.Data["type"])
+	assert.Equal(t, notification.ID.String(), message.Data["notificationID"])
+	assert.Equal(t, "1", message.Data["pondID"])
+}
@@ -0,0 +1,63 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/e-fish/api/pkg/common/helper/ctxutil"
+	"github.com/e-fish/api/pkg/common/infra/orm"
+	errornotification "github.com/e-fish/api/pkg/domain/notification/error-notification"
+	"github.com/e-fish/api/pkg/domain/notification/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func newQuery(db *gorm.DB) Query {
+	return &query{
+		db: db,
+	}
+}
+
+type query struct {
+	db *gorm.DB
+}
+
+// ReadNotificationByUserID implements Query.
+// the newest notification of the user is the first
+func (q *query) ReadNotificationByUserID(ctx context.Context, input orm.Paginantion) (*model.ListNotificationOutput, error) {
+	var (
+		userID, _ = ctxutil.GetUserID(ctx)
+		res       = []*model.NotificationOutput{}
+		unread    int64
+		db        = q.db.Model(&model.NotificationOutput{}).Where("deleted_at IS NULL and user_id = ?", userID)
+	)
+
+	input.ObjectTable = model.NotificationOutput{}
+	input.Sort = "created_at"
+	input.Direction = "desc"
+
+	err := db.Session(&gorm.Session{}).Where("read_at IS NULL").Count(&unread).Error
+	if err != nil {
+		return nil, errornotification.ErrFailedFindNotification.AttacthDetail(map[string]any{"error": err})
+	}
+
+	err = db.Scopes(orm.Paginate(db.Session(&gorm.Session{}), &input)).Find(&res).Error
+	if err != nil {
+		return nil, errornotification.ErrFailedFindNotification.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return &model.ListNotificationOutput{
+		Limit:       input.Limit,
+		Page:        input.Page,
+		TotalRows:   input.TotalRows,
+		TotalPage:   input.TotalPage,
+		TotalUnread: unread,
+		Rows:        res,
+	}, nil
+}
+
+// lock implements Query.
+// lock table row to avoid race condition
+func (q *query) lock() Query {
+	db := q.db.Clauses(clause.Locking{Strength: "UPDATE"})
+	return &query{db: db}
+}
@@ -0,0 +1,39 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/e-fish/api/pkg/common/helper/config"
+	"github.com/e-fish/api/pkg/common/infra/firebase"
+	"github.com/e-fish/api/pkg/common/infra/orm"
+	"gorm.io/gorm"
+)
+
+// NewRepo create the notification repo, the push message is skipped when the messaging is nil
+// and the notification is only saved as the in-app notification
+func NewRepo(dbConfig config.DbConfig, messaging firebase.Messaging) (Repo, error) {
+	db, err := orm.CreateConnetionDB(dbConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NotificationRepo{
+		db:        db,
+		messaging: messaging,
+	}, err
+}
+
+type NotificationRepo struct {
+	db        *gorm.DB
+	messaging firebase.Messaging
+}
+
+// NewCommand implements Repo.
+func (a *NotificationRepo) NewCommand(ctx context.Context) Command {
+	return newCommand(ctx, a.db, a.messaging)
+}
+
+// NewQuery implements Repo.
+func (a *NotificationRepo) NewQuery() Query {
+	return newQuery(a.db)
+}
@@ -3,6 +3,7 @@ package pond
 import (
 	"context"
 
+	"github.com/e-fish/api/pkg/common/helper/geo"
 	"github.com/e-fish/api/pkg/domain/pond/model"
 	"github.com/google/uuid"
 )
@@ -19,6 +20,18 @@ type Command interface {
 
 	ResubmissionPond(ctx context.Context, input model.Resubmission) (*uuid.UUID, error)
 
+	AssignReviewerPond(ctx context.Context, input model.AssignReviewerInput) (*uuid.UUID, error)
+	ReviewBerkasPond(ctx context.Context, input model.ReviewBerkasInput) (*model.PondOutput, error)
+
+	CreatePool(ctx context.Context, input model.CreatePoolInput) (*uuid.UUID, error)
+	UpdatePool(ctx context.Context, input model.UpdatePoolInput) (*uuid.UUID, error)
+	ArchivePool(ctx context.Context, input model.ArchivePoolInput) (*uuid.UUID, error)
+
+	CreateTeam(ctx context.Context, input model.CreateTeamInput) (*uuid.UUID, error)
+	InviteTeamMember(ctx context.Context, input model.InviteTeamMemberInput) (*uuid.UUID, error)
+	AcceptTeamInvitation(ctx context.Context, input model.AcceptTeamInvitationInput) (*uuid.UUID, error)
+	RemoveTeamMember(ctx context.Context, input model.RemoveTeamMemberInput) (*uuid.UUID, error)
+
 	Rollback(ctx context.Context) error
 	Commit(ctx context.Context) error
 }
@@ -26,9 +39,20 @@ type Command interface {
 type Query interface {
 	GetListPondSubmission(ctx context.Context) ([]*model.PondOutput, error)
 	GetPondAdmin(ctx context.Context) (*model.PondOutput, error)
+	GetListMyPond(ctx context.Context) ([]*model.PondOutput, error)
 	GetPondByID(ctx context.Context, input uuid.UUID) (*model.PondOutput, error)
-	GetListPond(ctx context.Context) ([]*model.PondOutput, error)
+	GetListPond(ctx context.Context, nearby geo.Nearby) ([]*model.PondOutput, error)
 	GetListPool(ctx context.Context, input uuid.UUID) ([]*model.PoolOutput, error)
+	GetPoolByID(ctx context.Context, input uuid.UUID) (*model.PoolOutput, error)
+	GetListBerkasByPondID(ctx context.Context, input uuid.UUID) ([]model.BerkasOutput, error)
+	GetListReviewNoteByPondID(ctx context.Context, input uuid.UUID) ([]*model.ReviewNoteOutput, error)
+
+	GetListTeam(ctx context.Context) ([]*model.TeamOutput, error)
+	GetTeamByID(ctx context.Context, input uuid.UUID) (*model.TeamOutput, error)
+	GetTeamMember(ctx context.Context, teamID, userID uuid.UUID) (*model.TeamMemberOutput, error)
+	GetListTeamInvitation(ctx context.Context) ([]*model.TeamMemberOutput, error)
+	// CheckPondPermission check that the user is the owner of the pond or the member of the team of the pond with the action
+	CheckPondPermission(ctx context.Context, pondID uuid.UUID, action string) (*model.PondOutput, error)
 
 	lock() Query
 }
@@ -2,32 +2,42 @@ package pond
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/e-fish/api/pkg/common/helper/ctxutil"
 	"github.com/e-fish/api/pkg/common/infra/orm"
+	userModel "github.com/e-fish/api/pkg/domain/auth/model"
+	"github.com/e-fish/api/pkg/domain/notification"
+	notificationModel "github.com/e-fish/api/pkg/domain/notification/model"
 	errorpond "github.com/e-fish/api/pkg/domain/pond/error-pond"
 	"github.com/e-fish/api/pkg/domain/pond/model"
 	"github.com/e-fish/api/pkg/domain/verification"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
-func newCommand(ctx context.Context, db *gorm.DB, verificationRepo verification.Repo) Command {
+func newCommand(ctx context.Context, db *gorm.DB, verificationRepo verification.Repo, notificationRepo notification.Repo) Command {
 	var (
 		dbTxn = orm.BeginTxn(ctx, db)
 	)
 
 	return &command{
-		dbTxn:            dbTxn.WithContext(ctx),
-		query:            newQuery(dbTxn),
-		verificationRepo: verificationRepo,
+		dbTxn:               dbTxn.WithContext(ctx),
+		query:               newQuery(dbTxn),
+		verificationRepo:    verificationRepo,
+		notificationCommand: notificationRepo.NewCommand(ctx),
 	}
 }
 
 type command struct {
-	dbTxn            *gorm.DB
-	query            Query
-	verificationRepo verification.Repo
+	dbTxn               *gorm.DB
+	query               Query
+	verificationRepo    verification.Repo
+	notificationCommand notification.Command
 }
 
 // UpdatePond implements Command.
@@ -39,7 +49,12 @@ func (c *command) UpdatePond(ctx context.Context, input model.UpdatePondInput) (
 
 	updatePond := input.ToPond(userID, pondID)
 
-	err := c.dbTxn.Where("deleted_at IS NULL and id = ? and user_id = ?", pondID, userID).Updates(&updatePond).Error
+	_, err := c.query.CheckPondPermission(ctx, pondID, model.TEAM_MANAGE_POND)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.dbTxn.Where("deleted_at IS NULL and id = ?", pondID).Updates(&updatePond).Error
 	if err != nil {
 		return nil, errorpond.ErrFailedUpdatePond.AttacthDetail(map[string]any{"error": err})
 	}
@@ -60,7 +75,7 @@ func (c *command) UpdatePondStatus(ctx context.Context, input model.UpdatePondSt
 
 	updatePond := input.ToPond(userID)
 
-	pond, err := c.query.GetPondByID(ctx, input.PondID)
+	pond, err := c.query.lock().GetPondByID(ctx, input.PondID)
 	if err != nil {
 		return nil, err
 	}
@@ -70,15 +85,524 @@ func (c *command) UpdatePondStatus(ctx context.Context, input model.UpdatePondSt
 		return nil, errorpond.ErrCannotUpdateStatusPond.AttacthDetail(map[string]any{"already-status": pond.Status, "targer-status": updatePond.Status})
 	}
 
+	// the pond is only active when all the required berkas is approved by the review
+	if updatePond.Status == model.ACTIVED {
+		listBerkas, err := c.query.GetListBerkasByPondID(ctx, pond.ID)
+		if err != nil {
+			return nil, err
+		}
+		if !model.IsBerkasApproved(listBerkas) {
+			return nil, errorpond.ErrBerkasNotApproved
+		}
+	}
+
 	err = c.dbTxn.Where("deleted_at IS NULL and id = ?", updatePond.ID).Updates(&updatePond).Error
 	if err != nil {
 		return nil, errorpond.ErrFailedUpdatePond.AttacthDetail(map[string]any{"error": err})
 	}
 
+	err = c.createReviewNote(model.NewReviewNote(userID, pond.ID, updatePond.Status, input.Reasons))
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.emitPondStatus(ctx, model.PondStatusEvent{
+		PondID:   pond.ID,
+		UserID:   pond.UserID,
+		PondName: pond.Name,
+		Status:   updatePond.Status,
+		Reasons:  input.Reasons,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &updatePond.ID, nil
 
 }
 
+// AssignReviewerPond implements Command.
+// the pond in the submission is moved to the review when the reviewer is assigned
+func (c *command) AssignReviewerPond(ctx context.Context, input model.AssignReviewerInput) (*uuid.UUID, error) {
+	var (
+		userID, _ = ctxutil.GetUserID(ctx)
+		now       = time.Now()
+	)
+
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	if input.ReviewerID == uuid.Nil {
+		input.ReviewerID = userID
+	}
+
+	pond, err := c.query.lock().GetPondByID(ctx, input.PondID)
+	if err != nil {
+		return nil, err
+	}
+
+	if pond.Status != model.SUBMISION && pond.Status != model.REVIEWED {
+		return nil, errorpond.ErrCannotUpdateStatusPond.AttacthDetail(map[string]any{"already-status": pond.Status, "targer-status": model.REVIEWED})
+	}
+
+	reviewer := model.UserPond{}
+	err = c.dbTxn.Where("deleted_at IS NULL and id = ?", input.ReviewerID).Take(&reviewer).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorpond.ErrFoundReviewer.AttacthDetail(map[string]any{"reviewerID": input.ReviewerID})
+		}
+		return nil, errorpond.ErrFailedUpdatePond.AttacthDetail(map[string]any{"error": err})
+	}
+
+	// only the admin can review the pond
+	var admin int64
+	err = c.dbTxn.Table("user_roles").
+		Joins("JOIN roles ON roles.id = user_roles.role_id").
+		Where("user_roles.deleted_at IS NULL and roles.deleted_at IS NULL and user_roles.user_id = ? and roles.name = ?", input.ReviewerID, userModel.ADMIN).
+		Count(&admin).Error
+	if err != nil {
+		return nil, errorpond.ErrFailedUpdatePond.AttacthDetail(map[string]any{"error": err})
+	}
+	if admin < 1 {
+		return nil, errorpond.ErrReviewerNotAdmin.AttacthDetail(map[string]any{"reviewerID": input.ReviewerID})
+	}
+
+	err = c.dbTxn.Model(&model.Pond{}).Where("deleted_at IS NULL and id = ?", pond.ID).Updates(map[string]any{
+		"reviewer_id": input.ReviewerID,
+		"status":      model.REVIEWED,
+		"updated_at":  now,
+		"updated_by":  userID,
+	}).Error
+	if err != nil {
+		return nil, errorpond.ErrFailedUpdatePond.AttacthDetail(map[string]any{"error": err})
+	}
+
+	note := input.Note
+	if note == "" {
+		note = "reviewer assigned to " + reviewer.Name
+	}
+
+	err = c.createReviewNote(model.NewReviewNote(userID, pond.ID, model.REVIEWED, note))
+	if err != nil {
+		return nil, err
+	}
+
+	if pond.Status != model.REVIEWED {
+		err = c.emitPondStatus(ctx, model.PondStatusEvent{
+			PondID:   pond.ID,
+			UserID:   pond.UserID,
+			PondName: pond.Name,
+			Status:   model.REVIEWED,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &pond.ID, nil
+}
+
+// ReviewBerkasPond implements Command.
+// update the checklist of the berkas, the pond is active when all the required berkas is approved
+func (c *command) ReviewBerkasPond(ctx context.Context, input model.ReviewBerkasInput) (*model.PondOutput, error) {
+	var (
+		userID, _ = ctxutil.GetUserID(ctx)
+		now       = time.Now()
+	)
+
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	pond, err := c.query.lock().GetPondByID(ctx, input.PondID)
+	if err != nil {
+		return nil, err
+	}
+
+	if pond.Status != model.REVIEWED {
+		return nil, errorpond.ErrCannotUpdateStatusPond.AttacthDetail(map[string]any{"already-status": pond.Status, "expected-status": model.REVIEWED})
+	}
+	if pond.ReviewerID == nil || *pond.ReviewerID != userID {
+		return nil, errorpond.ErrNotReviewerPond
+	}
+
+	listBerkas, err := c.query.GetListBerkasByPondID(ctx, pond.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	berkasPond := map[uuid.UUID]bool{}
+	for _, v := range listBerkas {
+		berkasPond[v.ID] = true
+	}
+
+	for _, v := range input.ListBerkas {
+		if !berkasPond[v.BerkasID] {
+			return nil, errorpond.ErrFoundBerkas.AttacthDetail(map[string]any{"berkasID": v.BerkasID})
+		}
+
+		err = c.dbTxn.Model(&model.Berkas{}).Where("deleted_at IS NULL and id = ?", v.BerkasID).Updates(v.ToMap(userID)).Error
+		if err != nil {
+			return nil, errorpond.ErrFailedUpdatePond.AttacthDetail(map[string]any{"error": err})
+		}
+	}
+
+	listBerkas, err = c.query.GetListBerkasByPondID(ctx, pond.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if model.IsBerkasApproved(listBerkas) {
+		err = c.dbTxn.Model(&model.Pond{}).Where("deleted_at IS NULL and id = ?", pond.ID).Updates(map[string]any{
+			"status":     model.ACTIVED,
+			"updated_at": now,
+			"updated_by": userID,
+		}).Error
+		if err != nil {
+			return nil, errorpond.ErrFailedUpdatePond.AttacthDetail(map[string]any{"error": err})
+		}
+		pond.Status = model.ACTIVED
+
+		err = c.emitPondStatus(ctx, model.PondStatusEvent{
+			PondID:   pond.ID,
+			UserID:   pond.UserID,
+			PondName: pond.Name,
+			Status:   model.ACTIVED,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	note := input.Note
+	switch {
+	case note != "":
+	case pond.Status == model.ACTIVED:
+		note = "all required berkas approved"
+	default:
+		note = "berkas reviewed"
+	}
+
+	err = c.createReviewNote(model.NewReviewNote(userID, pond.ID, pond.Status, note))
+	if err != nil {
+		return nil, err
+	}
+
+	pond.ListBerkas = listBerkas
+	return pond, nil
+}
+
+// CreatePool implements Command.
+// the pool is added to the pond of the seller
+func (c *command) CreatePool(ctx context.Context, input model.CreatePoolInput) (*uuid.UUID, error) {
+	var (
+		userID, _ = ctxutil.GetUserID(ctx)
+		pondID, _ = ctxutil.GetPondID(ctx)
+	)
+
+	err := input.Validate()
+	if err != nil {
+		return nil, errorpond.ErrValidateInputPool.AttacthDetail(map[string]any{"error": err})
+	}
+
+	_, err = c.query.CheckPondPermission(ctx, pondID, model.TEAM_MANAGE_POND)
+	if err != nil {
+		return nil, err
+	}
+
+	newPool := input.ToPool(userID, pondID)
+
+	err = c.dbTxn.Create(&newPool).Error
+	if err != nil {
+		return nil, errorpond.ErrCreatePool.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return &newPool.ID, nil
+}
+
+// UpdatePool implements Command.
+func (c *command) UpdatePool(ctx context.Context, input model.UpdatePoolInput) (*uuid.UUID, error) {
+	var (
+		userID, _ = ctxutil.GetUserID(ctx)
+	)
+
+	if input.ID == uuid.Nil {
+		return nil, errorpond.ErrValidateInputPool.AttacthDetail(map[string]any{"id": "empty"})
+	}
+
+	err := input.Validate()
+	if err != nil {
+		return nil, errorpond.ErrValidateInputPool.AttacthDetail(map[string]any{"error": err})
+	}
+
+	_, err = c.readPoolSeller(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.dbTxn.Model(&model.Pool{}).Where("deleted_at IS NULL and id = ?", input.ID).Updates(input.ToMap(userID)).Error
+	if err != nil {
+		return nil, errorpond.ErrUpdatePool.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return &input.ID, nil
+}
+
+// ArchivePool implements Command.
+// the pool with the active budidaya is checked by the caller, the pond doesn't know the budidaya
+func (c *command) ArchivePool(ctx context.Context, input model.ArchivePoolInput) (*uuid.UUID, error) {
+	var (
+		userID, _ = ctxutil.GetUserID(ctx)
+		now       = time.Now()
+	)
+
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = c.readPoolSeller(ctx, input.PoolID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.dbTxn.Model(&model.Pool{}).Where("deleted_at IS NULL and id = ?", input.PoolID).Updates(map[string]any{
+		"archived_at": now,
+		"updated_at":  now,
+		"updated_by":  userID,
+	}).Error
+	if err != nil {
+		return nil, errorpond.ErrUpdatePool.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return &input.PoolID, nil
+}
+
+// readPoolSeller lock the pool of the pond of the seller, the archived pool can't be changed
+func (c *command) readPoolSeller(ctx context.Context, poolID uuid.UUID) (*model.PoolOutput, error) {
+	pondID, _ := ctxutil.GetPondID(ctx)
+
+	_, err := c.query.CheckPondPermission(ctx, pondID, model.TEAM_MANAGE_POND)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := c.query.lock().GetPoolByID(ctx, poolID)
+	if err != nil {
+		return nil, err
+	}
+
+	if pool.PondID != pondID {
+		return nil, errorpond.ErrFoundPool.AttacthDetail(map[string]any{"id": poolID})
+	}
+	if pool.ArchivedAt != nil {
+		return nil, errorpond.ErrPoolArchived.AttacthDetail(map[string]any{"id": poolID})
+	}
+
+	return pool, nil
+}
+
+// CreateTeam implements Command.
+// the creator of the team is the owner
+func (c *command) CreateTeam(ctx context.Context, input model.CreateTeamInput) (*uuid.UUID, error) {
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := c.readUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	newTeam := input.ToTeam(*user)
+
+	err = c.dbTxn.Create(&newTeam).Error
+	if err != nil {
+		return nil, errorpond.ErrCreateTeam.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return &newTeam.ID, nil
+}
+
+// InviteTeamMember implements Command.
+// the invitation is sent to the email, the user of the email is notified when he is already registered
+func (c *command) InviteTeamMember(ctx context.Context, input model.InviteTeamMemberInput) (*uuid.UUID, error) {
+	var (
+		userID, _ = ctxutil.GetUserID(ctx)
+	)
+
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	inviter, err := c.query.GetTeamMember(ctx, input.TeamID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !model.CanInviteMember(inviter.Role, input.Role) {
+		return nil, errorpond.ErrTeamPermission.AttacthDetail(map[string]any{"role": inviter.Role, "invite": input.Role})
+	}
+
+	var exist int64
+	err = c.dbTxn.Model(&model.TeamMember{}).Where("deleted_at IS NULL and team_id = ? and email = ?", input.TeamID, input.Email).Count(&exist).Error
+	if err != nil {
+		return nil, errorpond.ErrUpdateTeamMember.AttacthDetail(map[string]any{"error": err})
+	}
+	if exist > 0 {
+		return nil, errorpond.ErrTeamMemberExist.AttacthDetail(map[string]any{"email": input.Email})
+	}
+
+	team, err := c.query.GetTeamByID(ctx, input.TeamID)
+	if err != nil {
+		return nil, err
+	}
+
+	member := input.ToTeamMember(userID)
+
+	err = c.dbTxn.Create(&member).Error
+	if err != nil {
+		return nil, errorpond.ErrUpdateTeamMember.AttacthDetail(map[string]any{"error": err})
+	}
+
+	invitee := model.UserPond{}
+	err = c.dbTxn.Where("deleted_at IS NULL and LOWER(email) = ?", input.Email).Take(&invitee).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errorpond.ErrUpdateTeamMember.AttacthDetail(map[string]any{"error": err})
+	}
+
+	if err == nil {
+		_, err = c.notificationCommand.CreateNotification(ctx, notificationModel.CreateNotificationInput{
+			UserID: invitee.ID,
+			Type:   notificationModel.TEAM_INVITATION,
+			Title:  "Team invitation",
+			Body:   fmt.Sprintf("you are invited to %v as %v", team.Name, input.Role),
+			Data: map[string]string{
+				"teamID": team.ID.String(),
+				"role":   input.Role,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &member.ID, nil
+}
+
+// AcceptTeamInvitation implements Command.
+func (c *command) AcceptTeamInvitation(ctx context.Context, input model.AcceptTeamInvitationInput) (*uuid.UUID, error) {
+	var (
+		now    = time.Now()
+		member = model.TeamMember{}
+	)
+
+	user, err := c.readUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.dbTxn.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("deleted_at IS NULL and team_id = ? and email = ? and status = ?", input.TeamID, strings.ToLower(user.Email), model.MEMBER_INVITED).
+		Take(&member).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorpond.ErrFoundTeamInvitation.AttacthDetail(map[string]any{"teamID": input.TeamID})
+		}
+		return nil, errorpond.ErrUpdateTeamMember.AttacthDetail(map[string]any{"error": err})
+	}
+
+	err = c.dbTxn.Model(&model.TeamMember{}).Where("id = ?", member.ID).Updates(map[string]any{
+		"user_id":    user.ID,
+		"status":     model.MEMBER_ACTIVE,
+		"joined_at":  now,
+		"updated_at": now,
+		"updated_by": user.ID,
+	}).Error
+	if err != nil {
+		return nil, errorpond.ErrUpdateTeamMember.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return &member.ID, nil
+}
+
+// RemoveTeamMember implements Command.
+// the member is removed by the member that manage the member or leave the team by himself,
+// the owner can't be removed
+func (c *command) RemoveTeamMember(ctx context.Context, input model.RemoveTeamMemberInput) (*uuid.UUID, error) {
+	var (
+		userID, _ = ctxutil.GetUserID(ctx)
+		now       = time.Now()
+		member    = model.TeamMember{}
+	)
+
+	actor, err := c.query.GetTeamMember(ctx, input.TeamID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.dbTxn.Where("deleted_at IS NULL and team_id = ? and id = ?", input.TeamID, input.MemberID).Take(&member).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorpond.ErrFoundTeam.AttacthDetail(map[string]any{"memberID": input.MemberID})
+		}
+		return nil, errorpond.ErrUpdateTeamMember.AttacthDetail(map[string]any{"error": err})
+	}
+
+	if member.Role == model.OWNER {
+		return nil, errorpond.ErrTeamPermission.AttacthDetail(map[string]any{"role": model.OWNER, "error": "the owner can't be removed"})
+	}
+	if member.ID != actor.ID && !model.HasTeamPermission(actor.Role, model.TEAM_MANAGE_MEMBER) {
+		return nil, errorpond.ErrTeamPermission.AttacthDetail(map[string]any{"role": actor.Role, "action": model.TEAM_MANAGE_MEMBER})
+	}
+
+	err = c.dbTxn.Model(&model.TeamMember{}).Where("id = ?", member.ID).Updates(map[string]any{
+		"deleted_at": now,
+		"deleted_by": userID,
+	}).Error
+	if err != nil {
+		return nil, errorpond.ErrUpdateTeamMember.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return &member.ID, nil
+}
+
+func (c *command) readUser(ctx context.Context) (*model.UserPond, error) {
+	var (
+		userID, _ = ctxutil.GetUserID(ctx)
+		user      = model.UserPond{}
+	)
+
+	err := c.dbTxn.Where("deleted_at IS NULL and id = ?", userID).Take(&user).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorpond.ErrFoundUser
+		}
+		return nil, errorpond.ErrFailedFindTeam.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return &user, nil
+}
+
+// emitPondStatus save the notification of the owner in the same transaction,
+// the push message is sent after the transaction is committed
+func (c *command) emitPondStatus(ctx context.Context, event model.PondStatusEvent) error {
+	_, err := c.notificationCommand.CreateNotification(ctx, event.ToNotification())
+	return err
+}
+
+func (c *command) createReviewNote(note model.ReviewNote) error {
+	err := c.dbTxn.Create(&note).Error
+	if err != nil {
+		return errorpond.ErrCreateReviewNote.AttacthDetail(map[string]any{"error": err})
+	}
+	return nil
+}
+
 // CreatePond implements Command.
 func (c *command) CreatePond(ctx context.Context, input model.CreatePondInput) (*uuid.UUID, error) {
 	var (
@@ -91,6 +615,29 @@ func (c *command) CreatePond(ctx context.Context, input model.CreatePondInput) (
 		return nil, err
 	}
 
+	// the pond of the team is created by the member that manage the pond of the team
+	if input.Type == model.TEAM {
+		member, err := c.query.GetTeamMember(ctx, *input.TeamID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if !model.HasTeamPermission(member.Role, model.TEAM_MANAGE_POND) {
+			return nil, errorpond.ErrTeamPermission.AttacthDetail(map[string]any{"role": member.Role, "action": model.TEAM_MANAGE_POND})
+		}
+	}
+
+	// the pond id from the registration is used by the first pond, the next pond get the new id
+	if pondID == uuid.Nil {
+		pondID = uuid.New()
+	} else {
+		_, err = c.query.GetPondByID(ctx, pondID)
+		if err == nil {
+			pondID = uuid.New()
+		} else if !errorpond.ErrFoundPond.Is(err) {
+			return nil, err
+		}
+	}
+
 	newPond := input.ToPond(userID, pondID)
 
 	err = c.dbTxn.Create(&newPond).Error
@@ -113,38 +660,124 @@ func (c *command) ResubmissionPond(ctx context.Context, input model.Resubmission
 		return nil, err
 	}
 
+	pond, err := c.query.CheckPondPermission(ctx, pondID, model.TEAM_MANAGE_POND)
+	if err != nil {
+		return nil, err
+	}
+
 	updatedPond := input.ToPond(userID, pondID)
+	updatedPond.UserID = pond.UserID
 
 	err = c.dbTxn.Where("id = ?", pondID).Updates(&updatedPond).Error
 	if err != nil {
 		return nil, errorpond.ErrFailedUpdatePond.AttacthDetail(map[string]any{"error": err})
 	}
 
-	ListPool := model.UpdateListPoolInputToListPool(userID, pondID, input.ListPool)
+	err = c.resubmissionPool(ctx, pondID, input.ListPool)
+	if err != nil {
+		return nil, err
+	}
+
 	ListBerkas := model.UpdateListBerkasInputToListBerkas(userID, pondID, input.ListBerkas)
 
-	err = c.dbTxn.Save(&ListPool).Error
+	err = c.dbTxn.Save(&ListBerkas).Error
 	if err != nil {
 		return nil, errorpond.ErrFailedUpdatePond.AttacthDetail(map[string]any{"error": err})
 	}
-	err = c.dbTxn.Save(&ListBerkas).Error
+
+	err = c.createReviewNote(model.NewReviewNote(userID, pondID, model.SUBMISION, "resubmission"))
 	if err != nil {
-		return nil, errorpond.ErrFailedUpdatePond.AttacthDetail(map[string]any{"error": err})
+		return nil, err
+	}
+
+	err = c.emitPondStatus(ctx, model.PondStatusEvent{
+		PondID:   pondID,
+		UserID:   pond.UserID,
+		PondName: updatedPond.Name,
+		Status:   model.SUBMISION,
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &updatedPond.ID, nil
 }
 
+// resubmissionPool create the new pool and update the pool of the pond, the deleted pool is archived.
+// the archived pool is not changed and the active budidaya of the archived pool is checked by the caller
+func (c *command) resubmissionPool(ctx context.Context, pondID uuid.UUID, input []model.UpdatePoolInput) error {
+	var (
+		userID, _ = ctxutil.GetUserID(ctx)
+		now       = time.Now()
+	)
+
+	for _, v := range input {
+		if v.ID == uuid.Nil {
+			if v.IsDeleted {
+				continue
+			}
+
+			newPool := v.ToPool(userID, pondID)
+			newPool.ID = uuid.New()
+			newPool.OrmModel = orm.OrmModel{CreatedAt: now, CreatedBy: userID}
+
+			err := c.dbTxn.Create(&newPool).Error
+			if err != nil {
+				return errorpond.ErrFailedUpdatePond.AttacthDetail(map[string]any{"error": err})
+			}
+			continue
+		}
+
+		pool, err := c.query.lock().GetPoolByID(ctx, v.ID)
+		if err != nil {
+			return err
+		}
+
+		if pool.PondID != pondID {
+			return errorpond.ErrFoundPool.AttacthDetail(map[string]any{"id": v.ID})
+		}
+		if pool.ArchivedAt != nil {
+			continue
+		}
+
+		updated := v.ToMap(userID)
+		if v.IsDeleted {
+			updated = map[string]any{
+				"archived_at": now,
+				"updated_at":  now,
+				"updated_by":  userID,
+			}
+		}
+
+		err = c.dbTxn.Model(&model.Pool{}).Where("deleted_at IS NULL and id = ?", v.ID).Updates(updated).Error
+		if err != nil {
+			return errorpond.ErrUpdatePool.AttacthDetail(map[string]any{"error": err})
+		}
+	}
+
+	return nil
+}
+
 // Commit implements Command.
 func (c *command) Commit(ctx context.Context) error {
+	if err := c.notificationCommand.Commit(ctx); err != nil {
+		return errorpond.ErrCommit.AttacthDetail(map[string]any{"errors": err})
+	}
+
 	if err := orm.CommitTxn(ctx); err != nil {
 		return errorpond.ErrCommit.AttacthDetail(map[string]any{"errors": err})
 	}
+
+	c.notificationCommand.Push(ctx)
 	return nil
 }
 
 // Rollback implements Command.
 func (c *command) Rollback(ctx context.Context) error {
+	if err := c.notificationCommand.Rollback(ctx); err != nil {
+		return errorpond.ErrRollback.AttacthDetail(map[string]any{"errors": err})
+	}
+
 	if err := orm.RollbackTxn(ctx); err != nil {
 		return errorpond.ErrRollback.AttacthDetail(map[string]any{"errors": err})
 	}
@@ -50,4 +50,100 @@ var (
 		Code:    "CannotUpdateStatusPond",
 		Message: "failed to update status",
 	}
+
+	ErrFoundPool = werror.Error{
+		Code:    "ValidatedFailedFoundPool",
+		Message: "pool not found",
+	}
+
+	ErrValidateInputReview = werror.Error{
+		Code:    "ValidatedFailedInputReview",
+		Message: "failed validate input review pond",
+	}
+	ErrNotReviewerPond = werror.Error{
+		Code:    "NotReviewerPond",
+		Message: "only the reviewer of the pond can review the berkas",
+	}
+	ErrBerkasNotApproved = werror.Error{
+		Code:    "BerkasNotApproved",
+		Message: "all required berkas must be approved before the pond is active",
+	}
+	ErrFoundBerkas = werror.Error{
+		Code:    "ValidatedFailedFoundBerkas",
+		Message: "berkas not found",
+	}
+	ErrFoundReviewer = werror.Error{
+		Code:    "ValidatedFailedFoundReviewer",
+		Message: "reviewer not found",
+	}
+	ErrReviewerNotAdmin = werror.Error{
+		Code:    "ValidatedFailedReviewerNotAdmin",
+		Message: "the reviewer must be an admin",
+	}
+	ErrCreateReviewNote = werror.Error{
+		Code:    "FailedCreateReviewNote",
+		Message: "failed create review note",
+	}
+	ErrFailedFindReviewNote = werror.Error{
+		Code:    "FailedFindReviewNote",
+		Message: "failed find review note",
+	}
+
+	ErrValidateInputPool = werror.Error{
+		Code:    "ValidatedFailedInputPool",
+		Message: "failed validate input pool",
+	}
+	ErrCreatePool = werror.Error{
+		Code:    "FailedCreatePool",
+		Message: "failed create pool",
+	}
+	ErrUpdatePool = werror.Error{
+		Code:    "FailedUpdatePool",
+		Message: "failed update pool",
+	}
+	ErrPoolArchived = werror.Error{
+		Code:    "PoolArchived",
+		Message: "the pool is archived",
+	}
+	ErrPoolHasActiveBudidaya = werror.Error{
+		Code:    "PoolHasActiveBudidaya",
+		Message: "the pool still has the active budidaya",
+	}
+
+	ErrValidateInputTeam = werror.Error{
+		Code:    "ValidatedFailedInputTeam",
+		Message: "failed validate input team",
+	}
+	ErrCreateTeam = werror.Error{
+		Code:    "FailedCreateTeam",
+		Message: "failed create team",
+	}
+	ErrFoundTeam = werror.Error{
+		Code:    "ValidatedFailedFoundTeam",
+		Message: "team not found",
+	}
+	ErrFailedFindTeam = werror.Error{
+		Code:    "FailedFindTeam",
+		Message: "failed find team",
+	}
+	ErrTeamPermission = werror.Error{
+		Code:    "TeamPermissionDenied",
+		Message: "the role of the member doesn't have the permission",
+	}
+	ErrTeamMemberExist = werror.Error{
+		Code:    "TeamMemberExist",
+		Message: "the email is already invited to the team",
+	}
+	ErrFoundTeamInvitation = werror.Error{
+		Code:    "ValidatedFailedFoundTeamInvitation",
+		Message: "team invitation not found",
+	}
+	ErrUpdateTeamMember = werror.Error{
+		Code:    "FailedUpdateTeamMember",
+		Message: "failed update team member",
+	}
+	ErrFoundUser = werror.Error{
+		Code:    "ValidatedFailedFoundUser",
+		Message: "user not found",
+	}
 )
@@ -10,6 +10,21 @@ const (
 	DISABLED  = "di tolak"
 )
 
+// type of the pool
+const (
+	EARTHEN   = "earthen"
+	TARPAULIN = "tarpaulin"
+	CONCRETE  = "concrete"
+	CAGE      = "cage"
+)
+
+var ValidPoolType = map[string]bool{
+	EARTHEN:   true,
+	TARPAULIN: true,
+	CONCRETE:  true,
+	CAGE:      true,
+}
+
 var MapStatus = map[string]map[string]bool{
 	SUBMISION: {
 		SUBMISION: false,
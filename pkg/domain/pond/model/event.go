@@ -0,0 +1,48 @@
+package model
+
+import (
+	"fmt"
+
+	notificationModel "github.com/e-fish/api/pkg/domain/notification/model"
+	"github.com/google/uuid"
+)
+
+// PondStatusEvent is emitted when the status of the pond is changed,
+// it is delivered to the owner of the pond as the in-app and push notification
+type PondStatusEvent struct {
+	PondID   uuid.UUID
+	UserID   uuid.UUID
+	PondName string
+	Status   string
+	Reasons  string
+}
+
+var pondStatusTitle = map[string]string{
+	SUBMISION: "Pond submitted",
+	REVIEWED:  "Pond is being reviewed",
+	ACTIVED:   "Pond approved",
+	DISABLED:  "Pond rejected",
+}
+
+func (p PondStatusEvent) ToNotification() notificationModel.CreateNotificationInput {
+	body := fmt.Sprintf("the status of %v is %v", p.PondName, p.Status)
+	if p.Reasons != "" {
+		body = fmt.Sprintf("%v: %v", body, p.Reasons)
+	}
+
+	title, ok := pondStatusTitle[p.Status]
+	if !ok {
+		title = "Pond status updated"
+	}
+
+	return notificationModel.CreateNotificationInput{
+		UserID: p.UserID,
+		Type:   notificationModel.POND_STATUS,
+		Title:  title,
+		Body:   body,
+		Data: map[string]string{
+			"pondID": p.PondID.String(),
+			"status": p.Status,
+		},
+	}
+}
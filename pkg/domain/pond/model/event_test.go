@@ -0,0 +1,29 @@
+package model_test
+
+import (
+	"testing"
+
+	notificationModel "github.com/e-fish/api/pkg/domain/notification/model"
+	"github.com/e-fish/api/pkg/domain/pond/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPondStatusEvent(t *testing.T) {
+	event := model.PondStatusEvent{
+		PondID:   uuid.New(),
+		UserID:   uuid.New(),
+		PondName: "Kolam Sejahtera",
+		Status:   model.DISABLED,
+		Reasons:  "ktp is blur",
+	}
+
+	input := event.ToNotification()
+
+	assert.NoError(t, input.Validate())
+	assert.Equal(t, event.UserID, input.UserID)
+	assert.Equal(t, notificationModel.POND_STATUS, input.Type)
+	assert.Equal(t, "Pond rejected", input.Title)
+	assert.Equal(t, "the status of Kolam Sejahtera is di tolak: ktp is blur", input.Body)
+	assert.Equal(t, model.DISABLED, input.Data["status"])
+}
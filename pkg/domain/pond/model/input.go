@@ -10,19 +10,21 @@ import (
 )
 
 type CreatePondInput struct {
-	Name          string              `json:"name"`
-	CountryID     uuid.UUID           `gorm:"size:256" json:"countryID"`
-	ProvinceID    uuid.UUID           `gorm:"size:256" json:"provinceID"`
-	CityID        uuid.UUID           `gorm:"size:256" json:"cityID"`
-	DistrictID    uuid.UUID           `gorm:"size:256" json:"districtID"`
-	DetailAddress string              `json:"detailAddress"`
-	NoteAddress   string              `json:"noteAddress"`
-	Type          string              `json:"type"`
-	Latitude      float64             `json:"latitude"`
-	Longitude     float64             `json:"longitude"`
-	Image         string              `json:"image"`
-	ListPool      []CreatePoolInput   `json:"listPool"`
-	ListBerkas    []CreateBerkasInput `json:"listBerkas"`
+	Name          string    `json:"name"`
+	CountryID     uuid.UUID `gorm:"size:256" json:"countryID"`
+	ProvinceID    uuid.UUID `gorm:"size:256" json:"provinceID"`
+	CityID        uuid.UUID `gorm:"size:256" json:"cityID"`
+	DistrictID    uuid.UUID `gorm:"size:256" json:"districtID"`
+	DetailAddress string    `json:"detailAddress"`
+	NoteAddress   string    `json:"noteAddress"`
+	Type          string    `json:"type"`
+	Latitude      float64   `json:"latitude"`
+	Longitude     float64   `json:"longitude"`
+	Image         string    `json:"image"`
+	// TeamID is required by the pond with the type team
+	TeamID     *uuid.UUID          `json:"teamID"`
+	ListPool   []CreatePoolInput   `json:"listPool"`
+	ListBerkas []CreateBerkasInput `json:"listBerkas"`
 }
 
 func (c *CreatePondInput) Validate() error {
@@ -47,14 +49,12 @@ func (c *CreatePondInput) Validate() error {
 		errs.Add(errorpond.ErrValidateInputPond.AttacthDetail(map[string]any{"Type": "empty"}))
 	}
 
-	// if c.Type == TEAM {
-	// if c.TeamID == nil {
-	// 	errs.Add(errorpond.ErrValidateInputPond.AttacthDetail(map[string]any{"Team": "empty"}))
-	// }
+	if c.Type == TEAM && (c.TeamID == nil || *c.TeamID == uuid.Nil) {
+		errs.Add(errorpond.ErrValidateInputPond.AttacthDetail(map[string]any{"Team": "empty"}))
+	}
 	if err := ValidateCreateberkasInput(c.ListBerkas); err != nil {
 		errs.Add(err)
 	}
-	// }
 
 	if len(c.ListPool) < 1 {
 		errs.Add(errorpond.ErrValidateInputPond.AttacthDetail(map[string]any{"Pool": "empty"}))
@@ -69,6 +69,10 @@ func (c *CreatePondInput) Validate() error {
 }
 
 func (c *CreatePondInput) ToPond(userID, pondID uuid.UUID) Pond {
+	var teamID *uuid.UUID
+	if c.Type == TEAM {
+		teamID = c.TeamID
+	}
 
 	return Pond{
 		ID:            pondID,
@@ -85,6 +89,7 @@ func (c *CreatePondInput) ToPond(userID, pondID uuid.UUID) Pond {
 		Longitude:     c.Longitude,
 		Status:        SUBMISION,
 		Image:         c.Image,
+		TeamID:        teamID,
 		ListPool:      ListPoolInputToListPool(userID, pondID, c.ListPool),
 		ListBerkas:    ListBerkasInputToListBerkas(userID, pondID, c.ListBerkas),
 		OrmModel: orm.OrmModel{
@@ -134,6 +139,9 @@ func (c *CreateBerkasInput) ToBerkas(userID uuid.UUID, pondID uuid.UUID) Berkas
 		PondID: pondID,
 		Name:   c.Name,
 		File:   c.File,
+		// every berkas is required until the reviewer mark it as optional
+		Required:     true,
+		ReviewStatus: BERKAS_PENDING,
 		OrmModel: orm.OrmModel{
 			CreatedAt: time.Now(),
 			CreatedBy: userID,
@@ -149,10 +157,13 @@ func ListBerkasInputToListBerkas(userID, pondID uuid.UUID, input []CreateBerkasI
 }
 
 type CreatePoolInput struct {
-	Name  string  `json:"name"`
-	Long  float64 `json:"long"`
-	Wide  float64 `json:"wide"`
-	Image string  `json:"image"`
+	Name        string  `json:"name"`
+	Long        float64 `json:"long"`
+	Wide        float64 `json:"wide"`
+	Image       string  `json:"image"`
+	Depth       float64 `json:"depth"`
+	WaterSource string  `json:"waterSource"`
+	PoolType    string  `json:"poolType"`
 }
 
 func (c *CreatePoolInput) Validate() error {
@@ -170,6 +181,9 @@ func (c *CreatePoolInput) Validate() error {
 	if c.Image == "" {
 		errs.Add(errorpond.ErrValidateInputbBerkas.AttacthDetail(map[string]any{"Image": "empty"}))
 	}
+	if err := validatePoolDetail(c.Depth, c.PoolType); err != nil {
+		errs.Add(err)
+	}
 
 	return errs.Return()
 }
@@ -189,13 +203,16 @@ func ValidateCreatePoolInput(input []CreatePoolInput) error {
 
 func (c *CreatePoolInput) ToPool(userID uuid.UUID, pondID uuid.UUID) Pool {
 	return Pool{
-		ID:       uuid.New(),
-		PondID:   pondID,
-		Name:     c.Name,
-		Long:     c.Long,
-		Wide:     c.Wide,
-		Image:    c.Image,
-		OrmModel: orm.OrmModel{CreatedAt: time.Now(), CreatedBy: userID},
+		ID:          uuid.New(),
+		PondID:      pondID,
+		Name:        c.Name,
+		Long:        c.Long,
+		Wide:        c.Wide,
+		Image:       c.Image,
+		Depth:       c.Depth,
+		WaterSource: c.WaterSource,
+		PoolType:    c.PoolType,
+		OrmModel:    orm.OrmModel{CreatedAt: time.Now(), CreatedBy: userID},
 	}
 }
 
@@ -341,12 +358,15 @@ func (c *Resubmission) Validate() error {
 }
 
 type UpdatePoolInput struct {
-	ID        uuid.UUID `json:"id"`
-	Name      string    `json:"name"`
-	Long      float64   `json:"long"`
-	Wide      float64   `json:"wide"`
-	Image     string    `json:"image"`
-	IsDeleted bool      `json:"isDeleted"`
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Long        float64   `json:"long"`
+	Wide        float64   `json:"wide"`
+	Image       string    `json:"image"`
+	Depth       float64   `json:"depth"`
+	WaterSource string    `json:"waterSource"`
+	PoolType    string    `json:"poolType"`
+	IsDeleted   bool      `json:"isDeleted"`
 }
 
 type UpdateBerkasInput struct {
@@ -389,6 +409,9 @@ func (c *UpdateBerkasInput) ToBerkas(userID uuid.UUID, pondID uuid.UUID) Berkas
 			PondID: pondID,
 			Name:   c.Name,
 			File:   c.File,
+			// the berkas of the resubmission is reviewed again
+			Required:     true,
+			ReviewStatus: BERKAS_PENDING,
 			OrmModel: orm.OrmModel{
 				UpdatedAt: &now,
 				UpdatedBy: &userID,
@@ -428,6 +451,9 @@ func (c *UpdatePoolInput) Validate() error {
 	if c.Image == "" {
 		errs.Add(errorpond.ErrValidateInputbBerkas.AttacthDetail(map[string]any{"Image": "empty"}))
 	}
+	if err := validatePoolDetail(c.Depth, c.PoolType); err != nil {
+		errs.Add(err)
+	}
 
 	return errs.Return()
 }
@@ -456,6 +482,10 @@ func (c *UpdatePoolInput) ToPool(userID uuid.UUID, pondID uuid.UUID) Pool {
 			Long:   c.Long,
 			Wide:   c.Wide,
 			Image:  c.Image,
+
+			Depth:       c.Depth,
+			WaterSource: c.WaterSource,
+			PoolType:    c.PoolType,
 			OrmModel: orm.OrmModel{
 				UpdatedAt: &now,
 				UpdatedBy: &userID,
@@ -503,3 +533,14 @@ func (c *Resubmission) ToPond(userID, pondID uuid.UUID) Pond {
 		},
 	}
 }
+
+type SelectPondInput struct {
+	PondID uuid.UUID `json:"pondID"`
+}
+
+func (s *SelectPondInput) Validate() error {
+	if s.PondID == uuid.Nil {
+		return errorpond.ErrValidateInputPond.AttacthDetail(map[string]any{"pondID": "empty"})
+	}
+	return nil
+}
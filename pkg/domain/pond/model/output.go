@@ -2,6 +2,7 @@ package model
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/e-fish/api/pkg/domain/region/model"
 	"github.com/google/uuid"
@@ -9,15 +10,17 @@ import (
 )
 
 type TeamOutput struct {
-	ID         uuid.UUID    `gorm:"size:256" json:"id"`
-	Name       string       `json:"name"`
-	CountryID  uuid.UUID    `gorm:"size:256" json:"countryID"`
-	ProvinceID uuid.UUID    `gorm:"size:256" json:"provinceID"`
-	CityID     uuid.UUID    `gorm:"size:256" json:"cityID"`
-	DistrictID uuid.UUID    `gorm:"size:256" json:"districtID"`
-	Detail     string       `json:"detail"`
-	Note       string       `json:"note"`
-	ListPond   []PondOutput `gorm:"foreignKey:TeamID;references:ID" json:"listPond,omitempty"`
+	ID         uuid.UUID          `gorm:"size:256" json:"id"`
+	Name       string             `json:"name"`
+	OwnerID    uuid.UUID          `gorm:"size:256" json:"ownerID"`
+	CountryID  uuid.UUID          `gorm:"size:256" json:"countryID"`
+	ProvinceID uuid.UUID          `gorm:"size:256" json:"provinceID"`
+	CityID     uuid.UUID          `gorm:"size:256" json:"cityID"`
+	DistrictID uuid.UUID          `gorm:"size:256" json:"districtID"`
+	Detail     string             `json:"detail"`
+	Note       string             `json:"note"`
+	ListPond   []PondOutput       `gorm:"foreignKey:TeamID;references:ID" json:"listPond,omitempty"`
+	ListMember []TeamMemberOutput `gorm:"foreignKey:TeamID;references:ID" json:"listMember,omitempty"`
 }
 
 func (t *TeamOutput) TableName() string {
@@ -25,30 +28,36 @@ func (t *TeamOutput) TableName() string {
 }
 
 type PondOutput struct {
-	ID            uuid.UUID             `gorm:"size:256" json:"id"`
-	UserID        uuid.UUID             `json:"userID"`
-	User          *UserPond             `gorm:"foreignKey:UserID;references:ID" json:"user,omitempty"`
-	Name          string                `json:"name"`
-	CountryID     uuid.UUID             `gorm:"size:256" json:"countryID"`
-	Country       *model.CountryOutput  `gorm:"foreignKey:CountryID;references:ID" json:"country,omitempty"`
-	ProvinceID    uuid.UUID             `gorm:"size:256" json:"provinceID"`
-	Province      *model.ProvinceOutput `gorm:"foreignKey:ProvinceID;references:ID" json:"province,omitempty"`
-	CityID        uuid.UUID             `gorm:"size:256" json:"cityID"`
-	City          *model.CityOutput     `gorm:"foreignKey:CityID;references:ID" json:"city,omitempty"`
-	DistrictID    uuid.UUID             `gorm:"size:256" json:"districtID"`
-	District      *model.DistrictOutput `gorm:"foreignKey:DistrictID;references:ID" json:"district,omitempty"`
-	DetailAddress string                `json:"detailAddress"`
-	NoteAddress   string                `json:"noteAddress"`
-	Type          string                `json:"type"`
-	Latitude      float64               `json:"latitude"`
-	Longitude     float64               `json:"longitude"`
-	Url           string                `json:"url"`
-	TeamID        *uuid.UUID            `gorm:"size:256" json:"teamID,omitempty"`
-	Team          *TeamOutput           `json:"team,omitempty" gorm:"foreignKey:TeamID;references:ID"`
-	Status        string                `json:"status"`
-	Image         string                `json:"image"`
-	ListPool      []PoolOutput          `json:"listPool,omitempty" gorm:"foreignKey:PondID;references:ID"`
-	ListBerkas    []BerkasOutput        `json:"berkas,omitempty" gorm:"foreignKey:PondID;references:ID"`
+	ID             uuid.UUID             `gorm:"size:256" json:"id"`
+	UserID         uuid.UUID             `json:"userID"`
+	User           *UserPond             `gorm:"foreignKey:UserID;references:ID" json:"user,omitempty"`
+	Name           string                `json:"name"`
+	CountryID      uuid.UUID             `gorm:"size:256" json:"countryID"`
+	Country        *model.CountryOutput  `gorm:"foreignKey:CountryID;references:ID" json:"country,omitempty"`
+	ProvinceID     uuid.UUID             `gorm:"size:256" json:"provinceID"`
+	Province       *model.ProvinceOutput `gorm:"foreignKey:ProvinceID;references:ID" json:"province,omitempty"`
+	CityID         uuid.UUID             `gorm:"size:256" json:"cityID"`
+	City           *model.CityOutput     `gorm:"foreignKey:CityID;references:ID" json:"city,omitempty"`
+	DistrictID     uuid.UUID             `gorm:"size:256" json:"districtID"`
+	District       *model.DistrictOutput `gorm:"foreignKey:DistrictID;references:ID" json:"district,omitempty"`
+	DetailAddress  string                `json:"detailAddress"`
+	NoteAddress    string                `json:"noteAddress"`
+	Type           string                `json:"type"`
+	Latitude       float64               `json:"latitude"`
+	Longitude      float64               `json:"longitude"`
+	Url            string                `json:"url"`
+	TeamID         *uuid.UUID            `gorm:"size:256" json:"teamID,omitempty"`
+	Team           *TeamOutput           `json:"team,omitempty" gorm:"foreignKey:TeamID;references:ID"`
+	Status         string                `json:"status"`
+	Reasons        string                `json:"reasons"`
+	Image          string                `json:"image"`
+	ReviewerID     *uuid.UUID            `gorm:"size:256" json:"reviewerID,omitempty"`
+	Reviewer       *UserPond             `gorm:"foreignKey:ReviewerID;references:ID" json:"reviewer,omitempty"`
+	ListPool       []PoolOutput          `json:"listPool,omitempty" gorm:"foreignKey:PondID;references:ID"`
+	ListBerkas     []BerkasOutput        `json:"berkas,omitempty" gorm:"foreignKey:PondID;references:ID"`
+	ListReviewNote []ReviewNoteOutput    `json:"reviewNotes,omitempty" gorm:"foreignKey:PondID;references:ID"`
+	// Distance is the distance in km from the coordinate of the query
+	Distance *float64 `json:"distance,omitempty" gorm:"-"`
 }
 
 func (t *PondOutput) TableName() string {
@@ -66,6 +75,12 @@ type BerkasOutput struct {
 	Pond   *PondOutput `gorm:"foreignKey:PondID;references:ID" json:"pond,omitempty"`
 	Name   string      `json:"name"`
 	File   string      `json:"file"`
+
+	Required      bool       `json:"required"`
+	ReviewStatus  string     `json:"reviewStatus"`
+	ReviewComment string     `json:"reviewComment"`
+	ReviewedBy    *uuid.UUID `gorm:"size:256" json:"reviewedBy,omitempty"`
+	ReviewedAt    *time.Time `json:"reviewedAt,omitempty"`
 }
 
 func (t *BerkasOutput) TableName() string {
@@ -80,6 +95,11 @@ type PoolOutput struct {
 	Long   float64     `json:"long"`
 	Wide   float64     `json:"wide"`
 	Image  string      `json:"image"`
+
+	Depth       float64    `json:"depth"`
+	WaterSource string     `json:"waterSource"`
+	PoolType    string     `json:"poolType"`
+	ArchivedAt  *time.Time `json:"archivedAt,omitempty"`
 }
 
 func (t *PoolOutput) TableName() string {
@@ -10,6 +10,7 @@ import (
 type UserPond struct {
 	ID                  uuid.UUID `gorm:"size:256" json:"id"`
 	Name                string    `json:"name"`
+	Email               string    `json:"email"`
 	VarificationCode    string    `json:"varificationCode"`
 	ExpVerificationCode time.Time `json:"expVerificationCode"`
 }
@@ -21,6 +22,7 @@ func (u UserPond) TableName() string {
 type Team struct {
 	ID         uuid.UUID `gorm:"primaryKey,size:256"`
 	Name       string
+	OwnerID    uuid.UUID `gorm:"size:256"`
 	CountryID  uuid.UUID `gorm:"size:256"`
 	ProvinceID uuid.UUID `gorm:"size:256"`
 	CityID     uuid.UUID `gorm:"size:256"`
@@ -28,6 +30,7 @@ type Team struct {
 	Detail     string
 	Note       string
 	ListPond   []*Pond
+	ListMember []TeamMember
 	orm.OrmModel
 }
 
@@ -50,6 +53,7 @@ type Pond struct {
 	Status        string     `json:"status"`
 	Image         string     `json:"image"`
 	Reasons       string     `json:"reasons"`
+	ReviewerID    *uuid.UUID `gorm:"size:256" json:"reviewerID"`
 	ListPool      []Pool     `json:"listPool"`
 	ListBerkas    []Berkas   `json:"berkas"`
 	orm.OrmModel
@@ -61,6 +65,12 @@ type Berkas struct {
 	Pond   Pond
 	Name   string `json:"name"`
 	File   string `json:"file"`
+	// checklist of the review, the pond is active when all the required berkas is approved
+	Required      bool       `json:"required"`
+	ReviewStatus  string     `json:"reviewStatus"`
+	ReviewComment string     `json:"reviewComment"`
+	ReviewedBy    *uuid.UUID `gorm:"size:256" json:"reviewedBy"`
+	ReviewedAt    *time.Time `json:"reviewedAt"`
 	orm.OrmModel
 }
 
@@ -72,5 +82,11 @@ type Pool struct {
 	Long   float64   `json:"long"`
 	Wide   float64   `json:"wide"`
 	Image  string    `json:"image"`
+	// the depth is in m
+	Depth       float64 `json:"depth"`
+	WaterSource string  `json:"waterSource"`
+	PoolType    string  `json:"poolType"`
+	// the archived pool is not listed and can't be used by the new budidaya
+	ArchivedAt *time.Time `json:"archivedAt"`
 	orm.OrmModel
 }
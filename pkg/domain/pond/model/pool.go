@@ -0,0 +1,47 @@
+package model
+
+import (
+	"time"
+
+	errorpond "github.com/e-fish/api/pkg/domain/pond/error-pond"
+	"github.com/google/uuid"
+)
+
+// validatePoolDetail check the detail of the pool,
+// the pool type is optional for the client that doesn't send it yet
+func validatePoolDetail(depth float64, poolType string) error {
+	if depth < 0 {
+		return errorpond.ErrValidateInputPool.AttacthDetail(map[string]any{"depth": "can't be negative"})
+	}
+	if poolType != "" && !ValidPoolType[poolType] {
+		return errorpond.ErrValidateInputPool.AttacthDetail(map[string]any{"poolType": "must be earthen, tarpaulin, concrete or cage"})
+	}
+	return nil
+}
+
+// ToMap is the updated field of the pool by the update pool command,
+// the pool is archived by the archive pool command so the IsDeleted is not used
+func (c *UpdatePoolInput) ToMap(userID uuid.UUID) map[string]any {
+	return map[string]any{
+		"name":         c.Name,
+		"long":         c.Long,
+		"wide":         c.Wide,
+		"image":        c.Image,
+		"depth":        c.Depth,
+		"water_source": c.WaterSource,
+		"pool_type":    c.PoolType,
+		"updated_at":   time.Now(),
+		"updated_by":   userID,
+	}
+}
+
+type ArchivePoolInput struct {
+	PoolID uuid.UUID `json:"poolID"`
+}
+
+func (a *ArchivePoolInput) Validate() error {
+	if a.PoolID == uuid.Nil {
+		return errorpond.ErrValidateInputPool.AttacthDetail(map[string]any{"poolID": "empty"})
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/e-fish/api/pkg/domain/pond/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreatePoolInput(t *testing.T) {
+	input := model.CreatePoolInput{
+		Name:        "A1",
+		Long:        10,
+		Wide:        5,
+		Image:       "a1.jpg",
+		Depth:       1.5,
+		WaterSource: "well",
+		PoolType:    model.TARPAULIN,
+	}
+	assert.NoError(t, input.Validate())
+
+	pool := input.ToPool(uuid.New(), uuid.New())
+	assert.Equal(t, 1.5, pool.Depth)
+	assert.Equal(t, "well", pool.WaterSource)
+	assert.Equal(t, model.TARPAULIN, pool.PoolType)
+
+	input.PoolType = ""
+	assert.NoError(t, input.Validate())
+
+	input.PoolType = "plastic"
+	assert.Error(t, input.Validate())
+
+	input.PoolType = model.CAGE
+	input.Depth = -1
+	assert.Error(t, input.Validate())
+}
+
+func TestUpdatePoolInput(t *testing.T) {
+	input := model.UpdatePoolInput{
+		ID:        uuid.New(),
+		Name:      "A1",
+		Long:      10,
+		Wide:      5,
+		Image:     "a1.jpg",
+		PoolType:  model.CONCRETE,
+		IsDeleted: true,
+	}
+	assert.NoError(t, input.Validate())
+
+	data := input.ToMap(uuid.New())
+	assert.Equal(t, model.CONCRETE, data["pool_type"])
+	assert.NotContains(t, data, "deleted_at")
+
+	archive := model.ArchivePoolInput{}
+	assert.Error(t, archive.Validate())
+}
@@ -0,0 +1,149 @@
+package model
+
+import (
+	"time"
+
+	"github.com/e-fish/api/pkg/common/helper/werror"
+	"github.com/e-fish/api/pkg/common/infra/orm"
+	errorpond "github.com/e-fish/api/pkg/domain/pond/error-pond"
+	"github.com/google/uuid"
+)
+
+// status of the berkas in the checklist of the review
+const (
+	BERKAS_PENDING  = "pending"
+	BERKAS_APPROVED = "approved"
+	BERKAS_REJECTED = "rejected"
+)
+
+var validBerkasStatus = map[string]bool{
+	BERKAS_PENDING:  true,
+	BERKAS_APPROVED: true,
+	BERKAS_REJECTED: true,
+}
+
+// ReviewNote is the history of the review of the pond,
+// the status is the status of the pond after the note is created
+type ReviewNote struct {
+	ID     uuid.UUID `gorm:"primaryKey,size:256" json:"id"`
+	PondID uuid.UUID `gorm:"size:256" json:"pondID"`
+	UserID uuid.UUID `gorm:"size:256" json:"userID"`
+	Status string    `json:"status"`
+	Note   string    `json:"note"`
+	orm.OrmModel
+}
+
+func NewReviewNote(userID, pondID uuid.UUID, status, note string) ReviewNote {
+	return ReviewNote{
+		ID:     uuid.New(),
+		PondID: pondID,
+		UserID: userID,
+		Status: status,
+		Note:   note,
+		OrmModel: orm.OrmModel{
+			CreatedAt: time.Now(),
+			CreatedBy: userID,
+		},
+	}
+}
+
+type AssignReviewerInput struct {
+	PondID uuid.UUID `json:"pondID"`
+	// ReviewerID is the admin that review the pond, the default is the admin that assign the review
+	ReviewerID uuid.UUID `json:"reviewerID"`
+	Note       string    `json:"note"`
+}
+
+func (a *AssignReviewerInput) Validate() error {
+	if a.PondID == uuid.Nil {
+		return errorpond.ErrValidateInputReview.AttacthDetail(map[string]any{"pondID": "empty"})
+	}
+	return nil
+}
+
+type ReviewBerkasInput struct {
+	PondID     uuid.UUID                 `json:"pondID"`
+	ListBerkas []ReviewBerkasDetailInput `json:"listBerkas"`
+	Note       string                    `json:"note"`
+}
+
+type ReviewBerkasDetailInput struct {
+	BerkasID uuid.UUID `json:"berkasID"`
+	Status   string    `json:"status"`
+	Comment  string    `json:"comment"`
+	// Required is nil when the reviewer doesn't change the required flag of the berkas
+	Required *bool `json:"required"`
+}
+
+func (r *ReviewBerkasInput) Validate() error {
+	errs := werror.NewError("error validate input")
+
+	if r.PondID == uuid.Nil {
+		errs.Add(errorpond.ErrValidateInputReview.AttacthDetail(map[string]any{"pondID": "empty"}))
+	}
+	if len(r.ListBerkas) < 1 {
+		errs.Add(errorpond.ErrValidateInputReview.AttacthDetail(map[string]any{"listBerkas": "empty"}))
+	}
+
+	for _, v := range r.ListBerkas {
+		if v.BerkasID == uuid.Nil {
+			errs.Add(errorpond.ErrValidateInputReview.AttacthDetail(map[string]any{"berkasID": "empty"}))
+		}
+		if !validBerkasStatus[v.Status] {
+			errs.Add(errorpond.ErrValidateInputReview.AttacthDetail(map[string]any{"status": "must be pending, approved or rejected", "berkasID": v.BerkasID}))
+		}
+		if v.Status == BERKAS_REJECTED && v.Comment == "" {
+			errs.Add(errorpond.ErrValidateInputReview.AttacthDetail(map[string]any{"comment": "empty", "berkasID": v.BerkasID}))
+		}
+	}
+
+	return errs.Return()
+}
+
+func (r *ReviewBerkasDetailInput) ToMap(userID uuid.UUID) map[string]any {
+	now := time.Now()
+
+	data := map[string]any{
+		"review_status":  r.Status,
+		"review_comment": r.Comment,
+		"reviewed_by":    userID,
+		"reviewed_at":    now,
+		"updated_at":     now,
+		"updated_by":     userID,
+	}
+	if r.Required != nil {
+		data["required"] = *r.Required
+	}
+
+	return data
+}
+
+// IsBerkasApproved check that all the required berkas of the pond is approved,
+// the pond without the required berkas can't be approved
+func IsBerkasApproved(listBerkas []BerkasOutput) bool {
+	required := 0
+	for _, v := range listBerkas {
+		if !v.Required {
+			continue
+		}
+		if v.ReviewStatus != BERKAS_APPROVED {
+			return false
+		}
+		required++
+	}
+	return required > 0
+}
+
+type ReviewNoteOutput struct {
+	ID        uuid.UUID `gorm:"size:256" json:"id"`
+	PondID    uuid.UUID `gorm:"size:256" json:"pondID"`
+	UserID    uuid.UUID `gorm:"size:256" json:"userID"`
+	User      *UserPond `gorm:"foreignKey:UserID;references:ID" json:"user,omitempty"`
+	Status    string    `json:"status"`
+	Note      string    `json:"note"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (r *ReviewNoteOutput) TableName() string {
+	return "review_notes"
+}
@@ -0,0 +1,61 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/e-fish/api/pkg/domain/pond/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsBerkasApproved(t *testing.T) {
+	t.Run("AllRequiredApproved", func(t *testing.T) {
+		listBerkas := []model.BerkasOutput{
+			{Required: true, ReviewStatus: model.BERKAS_APPROVED},
+			{Required: false, ReviewStatus: model.BERKAS_REJECTED},
+		}
+		assert.True(t, model.IsBerkasApproved(listBerkas))
+	})
+
+	t.Run("RequiredPending", func(t *testing.T) {
+		listBerkas := []model.BerkasOutput{
+			{Required: true, ReviewStatus: model.BERKAS_APPROVED},
+			{Required: true, ReviewStatus: model.BERKAS_PENDING},
+		}
+		assert.False(t, model.IsBerkasApproved(listBerkas))
+	})
+
+	t.Run("NoRequired", func(t *testing.T) {
+		listBerkas := []model.BerkasOutput{
+			{Required: false, ReviewStatus: model.BERKAS_APPROVED},
+		}
+		assert.False(t, model.IsBerkasApproved(listBerkas))
+	})
+}
+
+func TestReviewBerkasInput(t *testing.T) {
+	input := model.ReviewBerkasInput{
+		PondID: uuid.New(),
+		ListBerkas: []model.ReviewBerkasDetailInput{
+			{BerkasID: uuid.New(), Status: model.BERKAS_APPROVED},
+		},
+	}
+	assert.NoError(t, input.Validate())
+
+	input.ListBerkas[0].Status = model.BERKAS_REJECTED
+	assert.Error(t, input.Validate())
+
+	input.ListBerkas[0].Comment = "ktp is blur"
+	assert.NoError(t, input.Validate())
+
+	input.ListBerkas[0].Status = "done"
+	assert.Error(t, input.Validate())
+}
+
+func TestCreateBerkasInput(t *testing.T) {
+	input := model.CreateBerkasInput{Name: "ktp", File: "ktp.pdf"}
+	berkas := input.ToBerkas(uuid.New(), uuid.New())
+
+	assert.True(t, berkas.Required)
+	assert.Equal(t, model.BERKAS_PENDING, berkas.ReviewStatus)
+}
@@ -0,0 +1,211 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/e-fish/api/pkg/common/helper/werror"
+	"github.com/e-fish/api/pkg/common/infra/orm"
+	errorpond "github.com/e-fish/api/pkg/domain/pond/error-pond"
+	"github.com/google/uuid"
+)
+
+// role of the member of the team
+const (
+	OWNER   = "owner"
+	MANAGER = "manager"
+	WORKER  = "worker"
+)
+
+// status of the member of the team, the invited member is active after the invitation is accepted
+const (
+	MEMBER_INVITED = "invited"
+	MEMBER_ACTIVE  = "active"
+)
+
+// action of the member on the team and the pond of the team
+const (
+	TEAM_VIEW            = "view"
+	TEAM_MANAGE_MEMBER   = "manage-member"
+	TEAM_INVITE_WORKER   = "invite-worker"
+	TEAM_MANAGE_POND     = "manage-pond"
+	TEAM_MANAGE_BUDIDAYA = "manage-budidaya"
+	TEAM_WRITE_LOG       = "write-log"
+)
+
+// TeamPermission is the scoped permission of the role on the team,
+// the worker only write the log of the budidaya in the pond of the team
+var TeamPermission = map[string]map[string]bool{
+	OWNER: {
+		TEAM_VIEW:            true,
+		TEAM_MANAGE_MEMBER:   true,
+		TEAM_INVITE_WORKER:   true,
+		TEAM_MANAGE_POND:     true,
+		TEAM_MANAGE_BUDIDAYA: true,
+		TEAM_WRITE_LOG:       true,
+	},
+	MANAGER: {
+		TEAM_VIEW:            true,
+		TEAM_INVITE_WORKER:   true,
+		TEAM_MANAGE_POND:     true,
+		TEAM_MANAGE_BUDIDAYA: true,
+		TEAM_WRITE_LOG:       true,
+	},
+	WORKER: {
+		TEAM_VIEW:      true,
+		TEAM_WRITE_LOG: true,
+	},
+}
+
+func HasTeamPermission(role, action string) bool {
+	return TeamPermission[role][action]
+}
+
+// CanInviteMember check the role that can be invited by the inviter,
+// the owner invite the manager and the worker and the manager only invite the worker
+func CanInviteMember(inviterRole, role string) bool {
+	switch role {
+	case MANAGER:
+		return HasTeamPermission(inviterRole, TEAM_MANAGE_MEMBER)
+	case WORKER:
+		return HasTeamPermission(inviterRole, TEAM_INVITE_WORKER)
+	}
+	return false
+}
+
+type TeamMember struct {
+	ID     uuid.UUID `gorm:"primaryKey,size:256" json:"id"`
+	TeamID uuid.UUID `gorm:"size:256" json:"teamID"`
+	// UserID is nil until the invitation is accepted by the user of the email
+	UserID   *uuid.UUID `gorm:"size:256" json:"userID"`
+	Email    string     `json:"email"`
+	Role     string     `json:"role"`
+	Status   string     `json:"status"`
+	JoinedAt *time.Time `json:"joinedAt"`
+	orm.OrmModel
+}
+
+type CreateTeamInput struct {
+	Name       string    `json:"name"`
+	CountryID  uuid.UUID `json:"countryID"`
+	ProvinceID uuid.UUID `json:"provinceID"`
+	CityID     uuid.UUID `json:"cityID"`
+	DistrictID uuid.UUID `json:"districtID"`
+	Detail     string    `json:"detail"`
+	Note       string    `json:"note"`
+}
+
+func (c *CreateTeamInput) Validate() error {
+	errs := werror.NewError("error validate input")
+
+	if c.Name == "" {
+		errs.Add(errorpond.ErrValidateInputTeam.AttacthDetail(map[string]any{"name": "empty"}))
+	}
+	if c.CountryID == uuid.Nil {
+		errs.Add(errorpond.ErrValidateInputTeam.AttacthDetail(map[string]any{"countryID": "empty"}))
+	}
+	if c.ProvinceID == uuid.Nil {
+		errs.Add(errorpond.ErrValidateInputTeam.AttacthDetail(map[string]any{"provinceID": "empty"}))
+	}
+	if c.CityID == uuid.Nil {
+		errs.Add(errorpond.ErrValidateInputTeam.AttacthDetail(map[string]any{"cityID": "empty"}))
+	}
+	if c.DistrictID == uuid.Nil {
+		errs.Add(errorpond.ErrValidateInputTeam.AttacthDetail(map[string]any{"districtID": "empty"}))
+	}
+
+	return errs.Return()
+}
+
+// ToTeam create the team with the creator as the owner
+func (c *CreateTeamInput) ToTeam(user UserPond) Team {
+	var (
+		now    = time.Now()
+		teamID = uuid.New()
+	)
+
+	return Team{
+		ID:         teamID,
+		Name:       c.Name,
+		OwnerID:    user.ID,
+		CountryID:  c.CountryID,
+		ProvinceID: c.ProvinceID,
+		CityID:     c.CityID,
+		DistrictID: c.DistrictID,
+		Detail:     c.Detail,
+		Note:       c.Note,
+		ListMember: []TeamMember{
+			{
+				ID:       uuid.New(),
+				TeamID:   teamID,
+				UserID:   &user.ID,
+				Email:    user.Email,
+				Role:     OWNER,
+				Status:   MEMBER_ACTIVE,
+				JoinedAt: &now,
+				OrmModel: orm.OrmModel{CreatedAt: now, CreatedBy: user.ID},
+			},
+		},
+		OrmModel: orm.OrmModel{CreatedAt: now, CreatedBy: user.ID},
+	}
+}
+
+type InviteTeamMemberInput struct {
+	TeamID uuid.UUID `json:"teamID"`
+	Email  string    `json:"email"`
+	Role   string    `json:"role"`
+}
+
+func (i *InviteTeamMemberInput) Validate() error {
+	errs := werror.NewError("error validate input")
+
+	i.Email = strings.ToLower(strings.TrimSpace(i.Email))
+
+	if i.TeamID == uuid.Nil {
+		errs.Add(errorpond.ErrValidateInputTeam.AttacthDetail(map[string]any{"teamID": "empty"}))
+	}
+	if !strings.Contains(i.Email, "@") {
+		errs.Add(errorpond.ErrValidateInputTeam.AttacthDetail(map[string]any{"email": "invalid"}))
+	}
+	if i.Role != MANAGER && i.Role != WORKER {
+		errs.Add(errorpond.ErrValidateInputTeam.AttacthDetail(map[string]any{"role": "must be manager or worker"}))
+	}
+
+	return errs.Return()
+}
+
+func (i *InviteTeamMemberInput) ToTeamMember(userID uuid.UUID) TeamMember {
+	return TeamMember{
+		ID:       uuid.New(),
+		TeamID:   i.TeamID,
+		Email:    i.Email,
+		Role:     i.Role,
+		Status:   MEMBER_INVITED,
+		OrmModel: orm.OrmModel{CreatedAt: time.Now(), CreatedBy: userID},
+	}
+}
+
+type AcceptTeamInvitationInput struct {
+	TeamID uuid.UUID `json:"teamID"`
+}
+
+type RemoveTeamMemberInput struct {
+	TeamID   uuid.UUID `json:"teamID"`
+	MemberID uuid.UUID `json:"memberID"`
+}
+
+type TeamMemberOutput struct {
+	ID       uuid.UUID   `gorm:"size:256" json:"id"`
+	TeamID   uuid.UUID   `gorm:"size:256" json:"teamID"`
+	Team     *TeamOutput `gorm:"foreignKey:TeamID;references:ID" json:"team,omitempty"`
+	UserID   *uuid.UUID  `gorm:"size:256" json:"userID,omitempty"`
+	User     *UserPond   `gorm:"foreignKey:UserID;references:ID" json:"user,omitempty"`
+	Email    string      `json:"email"`
+	Role     string      `json:"role"`
+	Status   string      `json:"status"`
+	JoinedAt *time.Time  `json:"joinedAt,omitempty"`
+}
+
+func (t *TeamMemberOutput) TableName() string {
+	return "team_members"
+}
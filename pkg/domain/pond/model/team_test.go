@@ -0,0 +1,80 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/e-fish/api/pkg/domain/pond/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHasTeamPermission(t *testing.T) {
+	assert.True(t, model.HasTeamPermission(model.OWNER, model.TEAM_MANAGE_MEMBER))
+	assert.True(t, model.HasTeamPermission(model.MANAGER, model.TEAM_MANAGE_POND))
+	assert.True(t, model.HasTeamPermission(model.WORKER, model.TEAM_WRITE_LOG))
+	assert.False(t, model.HasTeamPermission(model.WORKER, model.TEAM_MANAGE_POND))
+	assert.False(t, model.HasTeamPermission("guest", model.TEAM_VIEW))
+}
+
+func TestCanInviteMember(t *testing.T) {
+	assert.True(t, model.CanInviteMember(model.OWNER, model.MANAGER))
+	assert.True(t, model.CanInviteMember(model.MANAGER, model.WORKER))
+	assert.False(t, model.CanInviteMember(model.MANAGER, model.MANAGER))
+	assert.False(t, model.CanInviteMember(model.WORKER, model.WORKER))
+	assert.False(t, model.CanInviteMember(model.OWNER, model.OWNER))
+}
+
+func TestInviteTeamMemberInput(t *testing.T) {
+	input := model.InviteTeamMemberInput{
+		TeamID: uuid.New(),
+		Email:  " Worker@Mail.com ",
+		Role:   model.WORKER,
+	}
+	assert.NoError(t, input.Validate())
+	assert.Equal(t, "worker@mail.com", input.Email)
+
+	member := input.ToTeamMember(uuid.New())
+	assert.Equal(t, model.MEMBER_INVITED, member.Status)
+	assert.Nil(t, member.UserID)
+
+	input.Role = model.OWNER
+	assert.Error(t, input.Validate())
+}
+
+func TestCreateTeamInputToTeam(t *testing.T) {
+	input := model.CreateTeamInput{
+		Name:       "Kelompok Tani",
+		CountryID:  uuid.New(),
+		ProvinceID: uuid.New(),
+		CityID:     uuid.New(),
+		DistrictID: uuid.New(),
+	}
+	assert.NoError(t, input.Validate())
+
+	user := model.UserPond{ID: uuid.New(), Email: "owner@mail.com"}
+	team := input.ToTeam(user)
+	assert.Equal(t, user.ID, team.OwnerID)
+	if assert.Len(t, team.ListMember, 1) {
+		assert.Equal(t, model.OWNER, team.ListMember[0].Role)
+		assert.Equal(t, model.MEMBER_ACTIVE, team.ListMember[0].Status)
+		assert.Equal(t, team.ID, team.ListMember[0].TeamID)
+	}
+}
+
+func TestCreatePondInputTeam(t *testing.T) {
+	input := model.CreatePondInput{
+		Name:       "Kolam",
+		CountryID:  uuid.New(),
+		ProvinceID: uuid.New(),
+		CityID:     uuid.New(),
+		DistrictID: uuid.New(),
+		Type:       model.TEAM,
+		ListPool:   []model.CreatePoolInput{{Name: "A1", Long: 10, Wide: 5, Image: "a1.jpg"}},
+		ListBerkas: []model.CreateBerkasInput{{Name: "ktp", File: "ktp.jpg"}},
+	}
+	assert.Error(t, input.Validate())
+
+	teamID := uuid.New()
+	input.TeamID = &teamID
+	assert.NoError(t, input.Validate())
+}
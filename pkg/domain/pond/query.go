@@ -3,8 +3,11 @@ package pond
 import (
 	"context"
 	"errors"
+	"sort"
+	"strings"
 
 	"github.com/e-fish/api/pkg/common/helper/ctxutil"
+	"github.com/e-fish/api/pkg/common/helper/geo"
 	userModel "github.com/e-fish/api/pkg/domain/auth/model"
 	errorpond "github.com/e-fish/api/pkg/domain/pond/error-pond"
 	"github.com/e-fish/api/pkg/domain/pond/model"
@@ -28,7 +31,7 @@ func (q *query) GetListPool(ctx context.Context, input uuid.UUID) ([]*model.Pool
 
 	pool := []*model.PoolOutput{}
 
-	err := q.db.Where("deleted_at IS NULL and pond_id = ?", input).Find(&pool).Error
+	err := q.db.Where("deleted_at IS NULL and archived_at IS NULL and pond_id = ?", input).Find(&pool).Error
 	if err != nil {
 		return nil, errorpond.ErrFailedFindPool.AttacthDetail(map[string]any{
 			"error":   err,
@@ -39,6 +42,58 @@ func (q *query) GetListPool(ctx context.Context, input uuid.UUID) ([]*model.Pool
 	return pool, nil
 }
 
+// GetPoolByID implements Query.
+func (q *query) GetPoolByID(ctx context.Context, input uuid.UUID) (*model.PoolOutput, error) {
+	var (
+		data = model.PoolOutput{}
+	)
+
+	err := q.db.Where("deleted_at IS NULL and id = ?", input).Take(&data).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorpond.ErrFoundPool.AttacthDetail(map[string]any{"id": input})
+		}
+		return nil, errorpond.ErrFailedFindPool.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return &data, nil
+}
+
+// GetListBerkasByPondID implements Query.
+func (q *query) GetListBerkasByPondID(ctx context.Context, input uuid.UUID) ([]model.BerkasOutput, error) {
+	var (
+		data = []model.BerkasOutput{}
+	)
+
+	err := q.db.Where("deleted_at IS NULL and pond_id = ?", input).Find(&data).Error
+	if err != nil {
+		return nil, errorpond.ErrFailedFindPond.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return data, nil
+}
+
+// GetListReviewNoteByPondID implements Query.
+// seller only see the review note of his pond
+func (q *query) GetListReviewNoteByPondID(ctx context.Context, input uuid.UUID) ([]*model.ReviewNoteOutput, error) {
+	var (
+		data       = []*model.ReviewNoteOutput{}
+		appType, _ = ctxutil.GetUserAppType(ctx)
+		pondID, _  = ctxutil.GetPondID(ctx)
+	)
+
+	if appType == userModel.SELLER && pondID != input {
+		return nil, errorpond.ErrFoundPond
+	}
+
+	err := q.db.Where("deleted_at IS NULL and pond_id = ?", input).Preload("User").Order("created_at DESC").Find(&data).Error
+	if err != nil {
+		return nil, errorpond.ErrFailedFindReviewNote.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return data, nil
+}
+
 // GetPondByID implements Query.
 func (q *query) GetPondByID(ctx context.Context, input uuid.UUID) (*model.PondOutput, error) {
 	var (
@@ -71,7 +126,8 @@ func (q *query) GetListPondSubmission(ctx context.Context) ([]*model.PondOutput,
 
 	err := q.db.Where("deleted_at IS NULL").
 		Preload("Team").
-		Preload("ListPool").Preload("ListBerkas").
+		Preload("ListPool").Preload("ListBerkas", "deleted_at IS NULL").
+		Preload("Reviewer").
 		Preload("Country").
 		Preload("Province").
 		Preload("City").
@@ -92,14 +148,19 @@ func (q *query) GetListPondSubmission(ctx context.Context) ([]*model.PondOutput,
 }
 
 // GetPondAdmin implements Query.
+// read the selected pond, the pond of the team is read by the member of the team
 func (q *query) GetPondAdmin(ctx context.Context) (*model.PondOutput, error) {
 	var (
-		userID, _ = ctxutil.GetUserID(ctx)
 		pondID, _ = ctxutil.GetPondID(ctx)
 		data      = model.PondOutput{}
 	)
 
-	err := q.db.Where("deleted_at IS NULL and id = ? and user_id = ?", pondID, userID).
+	_, err := q.CheckPondPermission(ctx, pondID, model.TEAM_VIEW)
+	if err != nil {
+		return nil, err
+	}
+
+	err = q.db.Where("deleted_at IS NULL and id = ?", pondID).
 		Preload("Country").
 		Preload("Province").
 		Preload("City").
@@ -117,7 +178,9 @@ func (q *query) GetPondAdmin(ctx context.Context) (*model.PondOutput, error) {
 }
 
 // GetListPond implements Query.
-func (q *query) GetListPond(ctx context.Context) ([]*model.PondOutput, error) {
+// when the coordinate is given the pond is sorted by the distance,
+// the radius is prefiltered by the bounding box and then filtered by the exact distance
+func (q *query) GetListPond(ctx context.Context, nearby geo.Nearby) ([]*model.PondOutput, error) {
 	var (
 		data       = []*model.PondOutput{}
 		appType, _ = ctxutil.GetUserAppType(ctx)
@@ -132,7 +195,17 @@ func (q *query) GetListPond(ctx context.Context) ([]*model.PondOutput, error) {
 		db = db.Preload("Team").Preload("ListBerkas").Preload("ListPool").Order("CASE WHEN status = '" + model.SUBMISION + "' THEN 1 ELSE 2 END, status")
 	}
 
-	err := db.Debug().
+	err := nearby.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	if !nearby.IsEmpty() && nearby.RadiusKm > 0 {
+		box := geo.NewBoundingBox(*nearby.Point, nearby.RadiusKm)
+		db = db.Where("latitude BETWEEN ? AND ? AND longitude BETWEEN ? AND ?", box.MinLatitude, box.MaxLatitude, box.MinLongitude, box.MaxLongitude)
+	}
+
+	err = db.Debug().
 		Preload("Country").
 		Preload("Province").
 		Preload("City").
@@ -142,7 +215,25 @@ func (q *query) GetListPond(ctx context.Context) ([]*model.PondOutput, error) {
 		return nil, errorpond.ErrFailedFindPond.AttacthDetail(map[string]any{"error": err})
 	}
 
-	return data, nil
+	if nearby.IsEmpty() {
+		return data, nil
+	}
+
+	nearest := []*model.PondOutput{}
+	for _, v := range data {
+		distance, ok := nearby.Distance(geo.Point{Latitude: v.Latitude, Longitude: v.Longitude})
+		if !ok {
+			continue
+		}
+		v.Distance = &distance
+		nearest = append(nearest, v)
+	}
+
+	sort.SliceStable(nearest, func(i, j int) bool {
+		return *nearest[i].Distance < *nearest[j].Distance
+	})
+
+	return nearest, nil
 }
 
 // lock implements Query.
@@ -151,3 +242,155 @@ func (q *query) lock() Query {
 	db := q.db.Clauses(clause.Locking{Strength: "UPDATE"})
 	return &query{db: db}
 }
+
+// GetListMyPond implements Query.
+// the pond of the user and the pond of the team where the user is the active member
+func (q *query) GetListMyPond(ctx context.Context) ([]*model.PondOutput, error) {
+	var (
+		userID, _ = ctxutil.GetUserID(ctx)
+		data      = []*model.PondOutput{}
+	)
+
+	team := q.db.Table("team_members").Select("team_id").
+		Where("deleted_at IS NULL and user_id = ? and status = ?", userID, model.MEMBER_ACTIVE)
+
+	err := q.db.Where("deleted_at IS NULL and (user_id = ? or team_id IN (?))", userID, team).
+		Preload("Country").
+		Preload("Province").
+		Preload("City").
+		Preload("District").
+		Order("created_at ASC").
+		Find(&data).Error
+	if err != nil {
+		return nil, errorpond.ErrFailedFindPond.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return data, nil
+}
+
+// GetListTeam implements Query.
+// the team of the active member
+func (q *query) GetListTeam(ctx context.Context) ([]*model.TeamOutput, error) {
+	var (
+		userID, _ = ctxutil.GetUserID(ctx)
+		data      = []*model.TeamOutput{}
+	)
+
+	member := q.db.Table("team_members").Select("team_id").
+		Where("deleted_at IS NULL and user_id = ? and status = ?", userID, model.MEMBER_ACTIVE)
+
+	err := q.db.Where("deleted_at IS NULL and id IN (?)", member).
+		Preload("ListMember", "deleted_at IS NULL").
+		Preload("ListPond", "deleted_at IS NULL").
+		Find(&data).Error
+	if err != nil {
+		return nil, errorpond.ErrFailedFindTeam.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return data, nil
+}
+
+// GetTeamByID implements Query.
+// the team is only read by the active member
+func (q *query) GetTeamByID(ctx context.Context, input uuid.UUID) (*model.TeamOutput, error) {
+	var (
+		userID, _ = ctxutil.GetUserID(ctx)
+		data      = model.TeamOutput{}
+	)
+
+	_, err := q.GetTeamMember(ctx, input, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = q.db.Where("deleted_at IS NULL and id = ?", input).
+		Preload("ListMember", "deleted_at IS NULL").
+		Preload("ListMember.User").
+		Preload("ListPond", "deleted_at IS NULL").
+		Take(&data).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorpond.ErrFoundTeam.AttacthDetail(map[string]any{"id": input})
+		}
+		return nil, errorpond.ErrFailedFindTeam.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return &data, nil
+}
+
+// GetTeamMember implements Query.
+func (q *query) GetTeamMember(ctx context.Context, teamID, userID uuid.UUID) (*model.TeamMemberOutput, error) {
+	var (
+		data = model.TeamMemberOutput{}
+	)
+
+	err := q.db.Where("deleted_at IS NULL and team_id = ? and user_id = ? and status = ?", teamID, userID, model.MEMBER_ACTIVE).Take(&data).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorpond.ErrFoundTeam.AttacthDetail(map[string]any{"id": teamID})
+		}
+		return nil, errorpond.ErrFailedFindTeam.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return &data, nil
+}
+
+// GetListTeamInvitation implements Query.
+// the invitation is matched by the email of the user
+func (q *query) GetListTeamInvitation(ctx context.Context) ([]*model.TeamMemberOutput, error) {
+	var (
+		userID, _ = ctxutil.GetUserID(ctx)
+		data      = []*model.TeamMemberOutput{}
+		user      = model.UserPond{}
+	)
+
+	err := q.db.Where("deleted_at IS NULL and id = ?", userID).Take(&user).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorpond.ErrFoundUser
+		}
+		return nil, errorpond.ErrFailedFindTeam.AttacthDetail(map[string]any{"error": err})
+	}
+
+	err = q.db.Where("deleted_at IS NULL and email = ? and status = ?", strings.ToLower(user.Email), model.MEMBER_INVITED).
+		Preload("Team").
+		Find(&data).Error
+	if err != nil {
+		return nil, errorpond.ErrFailedFindTeam.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return data, nil
+}
+
+// CheckPondPermission implements Query.
+func (q *query) CheckPondPermission(ctx context.Context, pondID uuid.UUID, action string) (*model.PondOutput, error) {
+	var (
+		userID, _ = ctxutil.GetUserID(ctx)
+	)
+
+	pond, err := q.GetPondByID(ctx, pondID)
+	if err != nil {
+		return nil, err
+	}
+
+	if pond.UserID == userID {
+		return pond, nil
+	}
+	if pond.TeamID == nil {
+		return nil, errorpond.ErrFoundPond
+	}
+
+	member, err := q.GetTeamMember(ctx, *pond.TeamID, userID)
+	if err != nil {
+		if errorpond.ErrFoundTeam.Is(err) {
+			return nil, errorpond.ErrFoundPond
+		}
+		return nil, err
+	}
+
+	if !model.HasTeamPermission(member.Role, action) {
+		return nil, errorpond.ErrTeamPermission.AttacthDetail(map[string]any{"role": member.Role, "action": action})
+	}
+
+	return pond, nil
+}
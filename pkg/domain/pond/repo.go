@@ -5,11 +5,12 @@ import (
 
 	"github.com/e-fish/api/pkg/common/helper/config"
 	"github.com/e-fish/api/pkg/common/infra/orm"
+	"github.com/e-fish/api/pkg/domain/notification"
 	"github.com/e-fish/api/pkg/domain/verification"
 	"gorm.io/gorm"
 )
 
-func NewRepo(dbConfig config.DbConfig, verificationRepo verification.Repo) (Repo, error) {
+func NewRepo(dbConfig config.DbConfig, verificationRepo verification.Repo, notificationRepo notification.Repo) (Repo, error) {
 	db, err := orm.CreateConnetionDB(dbConfig)
 	if err != nil {
 		return nil, err
@@ -19,6 +20,7 @@ func NewRepo(dbConfig config.DbConfig, verificationRepo verification.Repo) (Repo
 		DbConfig:         dbConfig,
 		db:               db,
 		verificationRepo: verificationRepo,
+		notificationRepo: notificationRepo,
 	}, err
 }
 
@@ -26,11 +28,12 @@ type Budidaya struct {
 	DbConfig         config.DbConfig
 	db               *gorm.DB
 	verificationRepo verification.Repo
+	notificationRepo notification.Repo
 }
 
 // NewCommand implements Repo.
 func (a *Budidaya) NewCommand(ctx context.Context) Command {
-	return newCommand(ctx, a.db, a.verificationRepo)
+	return newCommand(ctx, a.db, a.verificationRepo, a.notificationRepo)
 }
 
 // NewQuery implements Repo.
@@ -14,8 +14,18 @@ type Repo interface {
 
 type Command interface {
 	CreateOrder(ctx context.Context, input model.CreateOrderInput) (*uuid.UUID, error)
+	UpdateOrder(ctx context.Context, input model.UpdateOrderInput) (*uuid.UUID, error)
 	UpdateCancelOrder(ctx context.Context, input uuid.UUID) (*uuid.UUID, error)
 	UpdateSuccesOrder(ctx context.Context, input uuid.UUID) (*uuid.UUID, error)
+	UpdateOrderStatus(ctx context.Context, input model.UpdateOrderStatusInput) (*uuid.UUID, error)
+	AddCartItem(ctx context.Context, input model.AddCartItemInput) (*uuid.UUID, error)
+	UpdateCartItem(ctx context.Context, input model.UpdateCartItemInput) (*uuid.UUID, error)
+	Checkout(ctx context.Context, input model.CheckoutInput) (*uuid.UUID, error)
+	CreatePayment(ctx context.Context, input model.CreatePaymentInput) (*model.PaymentOutput, error)
+	HandlePaymentCallback(ctx context.Context, input model.PaymentCallbackInput) (*uuid.UUID, error)
+	ReconcileOrderHarvest(ctx context.Context, budidayaID uuid.UUID) (*model.ReconcileOrderOutput, error)
+	ReconcileOrderFailed(ctx context.Context, budidayaID uuid.UUID) (*model.ReconcileOrderOutput, error)
+	RefundPayment(ctx context.Context, paymentID uuid.UUID) (*uuid.UUID, error)
 
 	Rollback(ctx context.Context) error
 	Commit(ctx context.Context) error
@@ -25,7 +35,26 @@ type Query interface {
 	ReadOrder(ctx context.Context, input model.ReadInput) (*model.OrderOutputPagination, error)
 	ReadOrderByStatus(ctx context.Context, input model.ReadInput, status string) (*model.OrderOutputPagination, error)
 	ReadOrderByID(ctx context.Context, id uuid.UUID) (*model.OrderOutput, error)
+	ReadOrderDetail(ctx context.Context, id uuid.UUID) (*model.OrderOutput, error)
+	ReadOrderInvoice(ctx context.Context, id uuid.UUID) (*model.Invoice, error)
 	ReadAllOrderActive(ctx context.Context) ([]*model.Order, error)
+	ReadAllOrderReservationExpired(ctx context.Context) ([]*model.Order, error)
+	ReadOrderByBudidayaIDAndStatus(ctx context.Context, budidayaID uuid.UUID, status []string) ([]*model.Order, error)
+	ReadOrderStatusHistory(ctx context.Context, orderID uuid.UUID) ([]*model.OrderStatusHistoryOutput, error)
+	ReadCart(ctx context.Context) ([]*model.CartOutput, error)
+	ReadUserByID(ctx context.Context, id uuid.UUID) (*model.User, error)
+	ReadCartByID(ctx context.Context, id uuid.UUID) (*model.Cart, error)
+	ReadCartByPondID(ctx context.Context, pondID uuid.UUID) (*model.Cart, error)
+	ReadCartItemByID(ctx context.Context, id uuid.UUID) (*model.CartItem, error)
+	ReadPaymentByExternalID(ctx context.Context, externalID string) (*model.Payment, error)
+	ReadPaymentByOrderIDAndStatus(ctx context.Context, orderID uuid.UUID, status string) (*model.Payment, error)
+	ReadPaymentByID(ctx context.Context, id uuid.UUID) (*model.Payment, error)
+	ReadAllPaymentByStatus(ctx context.Context, status string) ([]*model.Payment, error)
+	ReadSalesRevenue(ctx context.Context, input model.SalesInput) ([]*model.SalesRevenueOutput, error)
+	ReadSalesFishSpecies(ctx context.Context, input model.SalesInput) ([]*model.SalesFishSpeciesOutput, error)
+	ReadSalesTopBuyer(ctx context.Context, input model.SalesInput) ([]*model.SalesTopBuyerOutput, error)
+	ReadSalesSummary(ctx context.Context, input model.SalesInput) (*model.SalesSummaryOutput, error)
 
 	lock() Query
+	checkPondSeller(ctx context.Context, action string) error
 }
@@ -3,63 +3,211 @@ package transaction
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/e-fish/api/pkg/common/helper/ctxutil"
+	"github.com/e-fish/api/pkg/common/helper/logger"
 	"github.com/e-fish/api/pkg/common/helper/werror"
 	"github.com/e-fish/api/pkg/common/infra/orm"
+	"github.com/e-fish/api/pkg/common/infra/payment"
+	userModel "github.com/e-fish/api/pkg/domain/auth/model"
 	"github.com/e-fish/api/pkg/domain/budidaya"
 	modelBudidaya "github.com/e-fish/api/pkg/domain/budidaya/model"
+	"github.com/e-fish/api/pkg/domain/pond"
+	pondModel "github.com/e-fish/api/pkg/domain/pond/model"
 	errortransaction "github.com/e-fish/api/pkg/domain/transaction/error-transaction"
 	"github.com/e-fish/api/pkg/domain/transaction/model"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
-func newCommand(ctx context.Context, db *gorm.DB, budidayaRepo budidaya.Repo) Command {
+func newCommand(ctx context.Context, db *gorm.DB, budidayaRepo budidaya.Repo, pondRepo pond.Repo, paymentGateway payment.PaymentGateway) Command {
 	var (
 		dbTxn = orm.BeginTxn(ctx, db)
 	)
 
 	return &command{
+		db:              db.WithContext(ctx),
 		dbTxn:           dbTxn.WithContext(ctx),
-		query:           newQuery(dbTxn),
+		query:           newQuery(dbTxn, pondRepo),
 		budidayaQuery:   budidayaRepo.NewQuery(),
 		budidayaCommand: budidayaRepo.NewCommand(ctx),
+		paymentGateway:  paymentGateway,
 	}
 }
 
 type command struct {
+	// db is used after the transaction is committed
+	db              *gorm.DB
 	dbTxn           *gorm.DB
 	query           Query
 	budidayaQuery   budidaya.Query
 	budidayaCommand budidaya.Command
+	paymentGateway  payment.PaymentGateway
+
+	// the refund is sent to the provider after the transaction is committed
+	refunds []refund
+}
+
+type refund struct {
+	payment model.Payment
+	reason  string
+}
+
+// UpdateOrder implements Command.
+// the order can be changed while it is not confirmed by the seller
+func (c *command) UpdateOrder(ctx context.Context, input model.UpdateOrderInput) (*uuid.UUID, error) {
+	var (
+		userID, _ = ctxutil.GetUserID(ctx)
+		actor, _  = ctxutil.GetUserAppType(ctx)
+	)
+
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	exist, err := c.query.lock().ReadOrderByID(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if exist.UserID != userID {
+		return nil, errortransaction.ErrAccessOrder.AttacthDetail(map[string]any{"id": input.ID})
+	}
+
+	if exist.Status != model.ACTIVE {
+		return nil, werror.Error{
+			Code:    errortransaction.ErrUpdateOrder.Code,
+			Message: fmt.Sprintf("the order status has [%s], failed to update the order", exist.Status),
+		}
+	}
+
+	price, err := c.budidayaQuery.ReadPriceListBudidayaByBiggerThanLimitAndBudidayaID(ctx, modelBudidaya.ReadPricelistBudidayaInput{
+		BudidayaID: exist.BudidayaID,
+		Qty:        input.Qty,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if price == nil {
+		return nil, errortransaction.ErrUpdateOrder.AttacthDetail(map[string]any{"price": "empty"})
+	}
+
+	delta := input.Qty - exist.Qty
+	switch {
+	case delta == 0:
+	case exist.ReservedUntil != nil:
+		reservedQty := modelBudidaya.UpdateBudidayaReservedQty{
+			ID:     exist.BudidayaID,
+			Qty:    delta,
+			Action: modelBudidaya.RESERVE,
+		}
+		if delta < 0 {
+			reservedQty.Qty = -delta
+			reservedQty.Action = modelBudidaya.RELEASE
+		}
+
+		_, err = c.budidayaCommand.UpdateBudidayaReservedQty(ctx, reservedQty)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		// the order created before the reservation, the qty is already sold
+		soldQty := modelBudidaya.UpdateBudidayaSoldQty{
+			ID:      exist.BudidayaID,
+			SoldQty: delta,
+		}
+		if delta < 0 {
+			soldQty.SoldQty = -delta
+			soldQty.IsCancel = true
+		}
+
+		_, err = c.budidayaCommand.UpdateBudidayaSoldQty(ctx, soldQty)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	updated := input.ToOrder(userID, *price)
+	err = c.dbTxn.Where("deleted_at IS NULL and id = ?", input.ID).Updates(&updated).Error
+	if err != nil {
+		return nil, errortransaction.ErrUpdateOrder.AttacthDetail(map[string]any{"error": err})
+	}
+
+	history := input.ToOrderStatusHistory(userID, actor, *exist)
+	err = c.dbTxn.Create(&history).Error
+	if err != nil {
+		return nil, errortransaction.ErrCreateOrderStatusHistory.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return &input.ID, nil
 }
 
 // UpdateCancelOrder implements Command.
 func (c *command) UpdateCancelOrder(ctx context.Context, input uuid.UUID) (*uuid.UUID, error) {
+	return c.UpdateOrderStatus(ctx, model.UpdateOrderStatusInput{
+		ID:     input,
+		Status: model.CANCEL,
+	})
+}
+
+// UpdateSuccesOrder implements Command.
+func (c *command) UpdateSuccesOrder(ctx context.Context, input uuid.UUID) (*uuid.UUID, error) {
+	return c.UpdateOrderStatus(ctx, model.UpdateOrderStatusInput{
+		ID:     input,
+		Status: model.SUCCESS,
+	})
+}
+
+// UpdateOrderStatus implements Command.
+func (c *command) UpdateOrderStatus(ctx context.Context, input model.UpdateOrderStatusInput) (*uuid.UUID, error) {
 	var (
 		userID, _ = ctxutil.GetUserID(ctx)
+		pondID, _ = ctxutil.GetPondID(ctx)
+		actor, _  = ctxutil.GetUserAppType(ctx)
 		today     = time.Now()
 	)
 
-	exist, err := c.query.lock().ReadOrderByID(ctx, input)
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.query.checkPondSeller(ctx, pondModel.TEAM_MANAGE_BUDIDAYA)
+	if err != nil {
+		return nil, err
+	}
+
+	exist, err := c.query.lock().ReadOrderByID(ctx, input.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	isTrue, ok := model.ValidateStatus[exist.Status][model.CANCEL]
-	if !ok || !isTrue {
+	switch actor {
+	case userModel.BUYER:
+		if exist.UserID != userID {
+			return nil, errortransaction.ErrAccessOrder.AttacthDetail(map[string]any{"id": input.ID})
+		}
+	case userModel.SELLER:
+		if exist.PondID != pondID {
+			return nil, errortransaction.ErrAccessOrder.AttacthDetail(map[string]any{"id": input.ID})
+		}
+	}
+
+	if !model.CanUpdateStatus(actor, exist.Status, input.Status) {
 		return nil, werror.Error{
 			Code:    errortransaction.ErrUpdateOrderStatus.Code,
-			Message: fmt.Sprintf("the order status has [%s], failed to update the order [%s]", exist.Status, model.CANCEL),
+			Message: fmt.Sprintf("the order status has [%s], failed to update the order [%s] as [%s]", exist.Status, input.Status, actor),
 		}
 	}
 
-	err = c.dbTxn.Where("deleted_at IS NULL and id = ?", input).Updates(
+	err = c.dbTxn.Where("deleted_at IS NULL and id = ?", input.ID).Updates(
 		&model.Order{
-			ID:     input,
-			Status: model.CANCEL,
+			ID:     input.ID,
+			Status: input.Status,
 			OrmModel: orm.OrmModel{
 				UpdatedBy: &userID,
 				UpdatedAt: &today,
@@ -68,58 +216,282 @@ func (c *command) UpdateCancelOrder(ctx context.Context, input uuid.UUID) (*uuid
 	).Error
 
 	if err != nil {
-		return nil, err
+		return nil, errortransaction.ErrUpdateOrderStatus.AttacthDetail(map[string]any{"error": err})
 	}
 
-	_, err = c.budidayaCommand.UpdateBudidayaSoldQty(ctx, modelBudidaya.UpdateBudidayaSoldQty{
-		ID:       exist.BudidayaID,
-		SoldQty:  exist.Qty,
-		IsCancel: true,
-	})
+	history := input.ToOrderStatusHistory(userID, actor, exist.Status)
+	err = c.dbTxn.Create(&history).Error
+	if err != nil {
+		return nil, errortransaction.ErrCreateOrderStatusHistory.AttacthDetail(map[string]any{"error": err})
+	}
 
+	if input.Status == model.REFUNDED {
+		err = c.refundPayment(ctx, input.ID, input.Reason)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = c.updateStock(ctx, *exist, input.Status)
 	if err != nil {
 		return nil, err
 	}
 
-	return &input, nil
+	return &input.ID, nil
 }
 
-// UpdateSuccesOrder implements Command.
-func (c *command) UpdateSuccesOrder(ctx context.Context, input uuid.UUID) (*uuid.UUID, error) {
+// updateStock move the qty of the order between the reserved, sold and available stock of the budidaya.
+// the reservation become sold when the order leave the active status,
+// and the qty is returned to the stock when the order is canceled or refunded
+func (c *command) updateStock(ctx context.Context, exist model.OrderOutput, status string) error {
 	var (
-		userID, _ = ctxutil.GetUserID(ctx)
-		today     = time.Now()
+		err        error
+		isReserved = exist.Status == model.ACTIVE && exist.ReservedUntil != nil
+		isRelease  = status == model.CANCEL || status == model.REFUNDED
 	)
 
-	exist, err := c.query.lock().ReadOrderByID(ctx, input)
-	if err != nil {
-		return nil, err
+	switch {
+	case isReserved && isRelease:
+		_, err = c.budidayaCommand.UpdateBudidayaReservedQty(ctx, modelBudidaya.UpdateBudidayaReservedQty{
+			ID:     exist.BudidayaID,
+			Qty:    exist.Qty,
+			Action: modelBudidaya.RELEASE,
+		})
+	case isReserved:
+		_, err = c.budidayaCommand.UpdateBudidayaReservedQty(ctx, modelBudidaya.UpdateBudidayaReservedQty{
+			ID:     exist.BudidayaID,
+			Qty:    exist.Qty,
+			Action: modelBudidaya.CONFIRM,
+		})
+	case isRelease && model.StockHeldStatus[exist.Status]:
+		_, err = c.budidayaCommand.UpdateBudidayaSoldQty(ctx, modelBudidaya.UpdateBudidayaSoldQty{
+			ID:       exist.BudidayaID,
+			SoldQty:  exist.Qty,
+			IsCancel: true,
+		})
 	}
 
-	isTrue, ok := model.ValidateStatus[exist.Status][model.SUCCESS]
-	if !ok || !isTrue {
-		return nil, werror.Error{
-			Code:    errortransaction.ErrUpdateOrderStatus.Code,
-			Message: fmt.Sprintf("the order status has [%s], failed to update the order [%s]", exist.Status, model.SUCCESS),
+	return err
+}
+
+// refundPayment mark the paid payment of the order as refunding, order without payment is refunded manually.
+// the provider is called after the commit, so the refund is not sent when the transaction is rolled back
+func (c *command) refundPayment(ctx context.Context, orderID uuid.UUID, reason string) error {
+	exist, err := c.query.lock().ReadPaymentByOrderIDAndStatus(ctx, orderID, payment.PAID)
+	if err != nil {
+		if errortransaction.ErrFoundPayment.Is(err) {
+			return nil
 		}
+		return err
 	}
 
-	err = c.dbTxn.Where("deleted_at IS NULL and id = ?", input).Updates(
-		&model.Order{
-			ID:     input,
-			Status: model.SUCCESS,
+	return c.queueRefund(ctx, *exist, reason)
+}
+
+func (c *command) queueRefund(ctx context.Context, exist model.Payment, reason string) error {
+	var (
+		userID, _ = ctxutil.GetUserID(ctx)
+		today     = time.Now()
+	)
+
+	err := c.dbTxn.Where("deleted_at IS NULL and id = ?", exist.ID).Updates(
+		&model.Payment{
+			Status: payment.REFUNDING,
 			OrmModel: orm.OrmModel{
 				UpdatedBy: &userID,
 				UpdatedAt: &today,
 			},
 		},
 	).Error
+	if err != nil {
+		return errortransaction.ErrUpdatePayment.AttacthDetail(map[string]any{"error": err, "id": exist.ID})
+	}
+
+	c.refunds = append(c.refunds, refund{payment: exist, reason: reason})
+	return nil
+}
+
+// RefundPayment implements Command.
+// retry the refund of the payment that is not refunded by the provider yet
+func (c *command) RefundPayment(ctx context.Context, paymentID uuid.UUID) (*uuid.UUID, error) {
+	exist, err := c.query.lock().ReadPaymentByID(ctx, paymentID)
+	if err != nil {
+		return nil, err
+	}
 
+	if exist.Status != payment.REFUNDING {
+		return nil, errortransaction.ErrUpdatePayment.AttacthDetail(map[string]any{"status": exist.Status, "id": paymentID})
+	}
+
+	c.refunds = append(c.refunds, refund{payment: *exist})
+	return &exist.ID, nil
+}
+
+// sendRefund send the queued refund to the provider, the payment id is the idempotency key,
+// the payment that is failed to refund stay as refunding and it is retried by the scheduler
+func (c *command) sendRefund(ctx context.Context) {
+	var (
+		userID, _ = ctxutil.GetUserID(ctx)
+		refunds   = c.refunds
+	)
+
+	c.refunds = nil
+
+	for _, v := range refunds {
+		result, err := c.paymentGateway.Refund(ctx, payment.RefundInput{
+			ReferenceID: v.payment.ID.String(),
+			ExternalID:  v.payment.ExternalID,
+			Amount:      v.payment.Amount,
+			Reason:      v.reason,
+		})
+		if err != nil {
+			logger.ErrorWithContext(ctx, "failed refund payment [%v] err: %v", v.payment.ID, err)
+			continue
+		}
+
+		today := time.Now()
+		err = c.db.Where("deleted_at IS NULL and id = ? and status = ?", v.payment.ID, payment.REFUNDING).Updates(
+			&model.Payment{
+				Status:   payment.REFUNDED,
+				RefundID: result.RefundID,
+				OrmModel: orm.OrmModel{
+					UpdatedBy: &userID,
+					UpdatedAt: &today,
+				},
+			},
+		).Error
+		if err != nil {
+			logger.ErrorWithContext(ctx, "failed update refunded payment [%v] err: %v", v.payment.ID, err)
+		}
+	}
+}
+
+// CreatePayment implements Command.
+func (c *command) CreatePayment(ctx context.Context, input model.CreatePaymentInput) (*model.PaymentOutput, error) {
+	var (
+		userID, _ = ctxutil.GetUserID(ctx)
+		today     = time.Now()
+	)
+
+	err := input.Validate()
 	if err != nil {
 		return nil, err
 	}
 
-	return &input, nil
+	order, err := c.query.lock().ReadOrderByID(ctx, input.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.UserID != userID {
+		return nil, errortransaction.ErrAccessOrder.AttacthDetail(map[string]any{"id": input.OrderID})
+	}
+
+	if order.Status != model.AWAITING_PAYMENT {
+		return nil, errortransaction.ErrCreatePayment.AttacthDetail(map[string]any{"status": order.Status})
+	}
+
+	// the buyer get the same invoice until it is expired
+	exist, err := c.query.ReadPaymentByOrderIDAndStatus(ctx, input.OrderID, payment.PENDING)
+	if err != nil && !errortransaction.ErrFoundPayment.Is(err) {
+		return nil, err
+	}
+	if exist != nil {
+		if exist.ExpiredAt.After(today) {
+			return exist.ToPaymentOutput(), nil
+		}
+
+		err = c.dbTxn.Where("deleted_at IS NULL and id = ?", exist.ID).Updates(
+			&model.Payment{
+				Status: payment.EXPIRED,
+				OrmModel: orm.OrmModel{
+					UpdatedBy: &userID,
+					UpdatedAt: &today,
+				},
+			},
+		).Error
+		if err != nil {
+			return nil, errortransaction.ErrUpdatePayment.AttacthDetail(map[string]any{"error": err, "id": exist.ID})
+		}
+	}
+
+	invoice, err := c.paymentGateway.CreateInvoice(ctx, payment.InvoiceInput{
+		ReferenceID: order.Code,
+		Amount:      order.Ammout,
+		Description: fmt.Sprintf("payment order %s", order.Code),
+		ExpiredAt:   today.Add(model.PaymentExpiredDuration),
+	})
+	if err != nil {
+		return nil, errortransaction.ErrCreatePayment.AttacthDetail(map[string]any{"error": err})
+	}
+
+	newPayment := input.ToPayment(userID, c.paymentGateway.Name(), *invoice)
+	err = c.dbTxn.Create(&newPayment).Error
+	if err != nil {
+		return nil, errortransaction.ErrCreatePayment.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return newPayment.ToPaymentOutput(), nil
+}
+
+// HandlePaymentCallback implements Command.
+func (c *command) HandlePaymentCallback(ctx context.Context, input model.PaymentCallbackInput) (*uuid.UUID, error) {
+	var (
+		today = time.Now()
+	)
+
+	data, err := c.paymentGateway.HandleCallback(ctx, input.Signature, input.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	exist, err := c.query.lock().ReadPaymentByExternalID(ctx, data.ExternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the provider can send the same callback more than once
+	if exist.Status != payment.PENDING {
+		return &exist.OrderID, nil
+	}
+
+	updated := model.Payment{
+		Status: data.Status,
+		OrmModel: orm.OrmModel{
+			UpdatedAt: &today,
+		},
+	}
+
+	switch data.Status {
+	case payment.PAID:
+		if data.Amount != exist.Amount {
+			return nil, errortransaction.ErrUpdatePayment.AttacthDetail(map[string]any{"amount": data.Amount, "id": exist.ID})
+		}
+		updated.PaidAt = &today
+		if data.PaidAt != nil {
+			updated.PaidAt = data.PaidAt
+		}
+	case payment.EXPIRED, payment.FAILED:
+	default:
+		return nil, errortransaction.ErrUpdatePayment.AttacthDetail(map[string]any{"status": data.Status, "id": exist.ID})
+	}
+
+	err = c.dbTxn.Where("deleted_at IS NULL and id = ?", exist.ID).Updates(&updated).Error
+	if err != nil {
+		return nil, errortransaction.ErrUpdatePayment.AttacthDetail(map[string]any{"error": err, "id": exist.ID})
+	}
+
+	if data.Status != payment.PAID {
+		return &exist.OrderID, nil
+	}
+
+	ctx = ctxutil.SetUserAppType(ctx, model.SYSTEM)
+	return c.UpdateOrderStatus(ctx, model.UpdateOrderStatusInput{
+		ID:     exist.OrderID,
+		Status: model.PAID,
+		Reason: fmt.Sprintf("payment %s", exist.ExternalID),
+		Source: model.SOURCE_PAYMENT,
+	})
 }
 
 // CreateOrder implements Command.
@@ -128,6 +500,17 @@ func (c *command) CreateOrder(ctx context.Context, input model.CreateOrderInput)
 		userID, _ = ctxutil.GetUserID(ctx)
 	)
 
+	newOrder, err := c.createOrder(ctx, userID, input, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newOrder.ID, nil
+}
+
+// createOrder create the order and take the qty from the budidaya stock,
+// the budidaya row is locked while the stock is validated
+func (c *command) createOrder(ctx context.Context, userID uuid.UUID, input model.CreateOrderInput, orderGroupID *uuid.UUID) (*model.Order, error) {
 	err := input.Validate()
 	if err != nil {
 		return nil, err
@@ -147,23 +530,309 @@ func (c *command) CreateOrder(ctx context.Context, input model.CreateOrderInput)
 	}
 
 	newOrder := input.ToOrder(userID, *price)
+	newOrder.OrderGroupID = orderGroupID
 
 	err = c.dbTxn.Create(&newOrder).Error
 	if err != nil {
 		return nil, errortransaction.ErrCreateOrder.AttacthDetail(map[string]any{"error": err})
 	}
 
-	_, err = c.budidayaCommand.UpdateBudidayaSoldQty(ctx, modelBudidaya.UpdateBudidayaSoldQty{
-		ID:       input.BudidayaID,
-		SoldQty:  input.Qty,
-		IsCancel: false,
+	history := newOrder.ToOrderStatusHistory(userID, userModel.BUYER)
+	err = c.dbTxn.Create(&history).Error
+	if err != nil {
+		return nil, errortransaction.ErrCreateOrderStatusHistory.AttacthDetail(map[string]any{"error": err})
+	}
+
+	_, err = c.budidayaCommand.UpdateBudidayaReservedQty(ctx, modelBudidaya.UpdateBudidayaReservedQty{
+		ID:     input.BudidayaID,
+		Qty:    input.Qty,
+		Action: modelBudidaya.RESERVE,
 	})
 
 	if err != nil {
 		return nil, err
 	}
 
-	return &newOrder.ID, nil
+	return &newOrder, nil
+}
+
+// AddCartItem implements Command.
+// the budidaya is added to the cart of its pond, the qty is added when the budidaya already in the cart
+func (c *command) AddCartItem(ctx context.Context, input model.AddCartItemInput) (*uuid.UUID, error) {
+	var (
+		userID, _ = ctxutil.GetUserID(ctx)
+		today     = time.Now()
+	)
+
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	budidaya, err := c.budidayaQuery.ReadBudidayaByID(ctx, input.BudidayaID)
+	if err != nil {
+		return nil, err
+	}
+
+	// lock the user, so the concurrent add to the cart of the same user wait until the first cart is created
+	_, err = c.query.lock().ReadUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	cart, err := c.query.lock().ReadCartByPondID(ctx, budidaya.PondID)
+	if err != nil {
+		if !errortransaction.ErrFoundCart.Is(err) {
+			return nil, err
+		}
+
+		newCart := model.NewCart(userID, budidaya.PondID)
+		err = c.dbTxn.Create(&newCart).Error
+		if err != nil {
+			return nil, errortransaction.ErrUpdateCart.AttacthDetail(map[string]any{"error": err})
+		}
+		cart = &newCart
+	}
+
+	for _, item := range cart.Items {
+		if item.BudidayaID != input.BudidayaID {
+			continue
+		}
+
+		err = c.dbTxn.Where("deleted_at IS NULL and id = ?", item.ID).Updates(&model.CartItem{
+			Qty: item.Qty + input.Qty,
+			OrmModel: orm.OrmModel{
+				UpdatedAt: &today,
+				UpdatedBy: &userID,
+			},
+		}).Error
+		if err != nil {
+			return nil, errortransaction.ErrUpdateCart.AttacthDetail(map[string]any{"error": err})
+		}
+
+		return &item.ID, nil
+	}
+
+	newItem := input.ToCartItem(userID, cart.ID)
+	err = c.dbTxn.Create(&newItem).Error
+	if err != nil {
+		return nil, errortransaction.ErrUpdateCart.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return &newItem.ID, nil
+}
+
+// UpdateCartItem implements Command.
+// the item is removed from the cart when the qty is 0
+func (c *command) UpdateCartItem(ctx context.Context, input model.UpdateCartItemInput) (*uuid.UUID, error) {
+	var (
+		userID, _ = ctxutil.GetUserID(ctx)
+		today     = time.Now()
+	)
+
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	exist, err := c.query.lock().ReadCartItemByID(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := model.CartItem{
+		Qty: input.Qty,
+		OrmModel: orm.OrmModel{
+			UpdatedAt: &today,
+			UpdatedBy: &userID,
+		},
+	}
+	if input.Qty == 0 {
+		updated.DeletedAt = &today
+		updated.DeletedBy = &userID
+	}
+
+	err = c.dbTxn.Where("deleted_at IS NULL and id = ?", exist.ID).Updates(&updated).Error
+	if err != nil {
+		return nil, errortransaction.ErrUpdateCart.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return &exist.ID, nil
+}
+
+// Checkout implements Command.
+// every item of the cart become an order under one order group,
+// when one of the item is failed the whole checkout must be rolled back
+func (c *command) Checkout(ctx context.Context, input model.CheckoutInput) (*uuid.UUID, error) {
+	var (
+		userID, _ = ctxutil.GetUserID(ctx)
+		today     = time.Now()
+	)
+
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	cart, err := c.query.lock().ReadCartByID(ctx, input.CartID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cart.Items) < 1 {
+		return nil, errortransaction.ErrCheckout.AttacthDetail(map[string]any{"cart": "empty"})
+	}
+
+	// lock the budidaya in the same order on every checkout to avoid deadlock
+	items := cart.Items
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].BudidayaID.String() < items[j].BudidayaID.String()
+	})
+
+	orderGroup := input.ToOrderGroup(userID, cart.PondID)
+	err = c.dbTxn.Create(&orderGroup).Error
+	if err != nil {
+		return nil, errortransaction.ErrCheckout.AttacthDetail(map[string]any{"error": err})
+	}
+
+	for _, item := range items {
+		newOrder, err := c.createOrder(ctx, userID, model.CreateOrderInput{
+			BudidayaID:  item.BudidayaID,
+			Qty:         item.Qty,
+			BookingDate: input.BookingDate,
+		}, &orderGroup.ID)
+		if err != nil {
+			return nil, errortransaction.ErrCheckout.AttacthDetail(map[string]any{"budidayaID": item.BudidayaID, "error": err})
+		}
+
+		if newOrder.PondID != cart.PondID {
+			return nil, errortransaction.ErrCheckout.AttacthDetail(map[string]any{"budidayaID": item.BudidayaID, "pondID": "different"})
+		}
+
+		orderGroup.Ammout += newOrder.Ammout
+	}
+
+	err = c.dbTxn.Where("deleted_at IS NULL and id = ?", orderGroup.ID).Updates(&model.OrderGroup{
+		Ammout: orderGroup.Ammout,
+	}).Error
+	if err != nil {
+		return nil, errortransaction.ErrCheckout.AttacthDetail(map[string]any{"error": err})
+	}
+
+	deleted := orm.OrmModel{
+		DeletedAt: &today,
+		DeletedBy: &userID,
+	}
+
+	err = c.dbTxn.Where("deleted_at IS NULL and cart_id = ?", cart.ID).Updates(&model.CartItem{OrmModel: deleted}).Error
+	if err != nil {
+		return nil, errortransaction.ErrCheckout.AttacthDetail(map[string]any{"error": err})
+	}
+
+	err = c.dbTxn.Where("deleted_at IS NULL and id = ?", cart.ID).Updates(&model.Cart{OrmModel: deleted}).Error
+	if err != nil {
+		return nil, errortransaction.ErrCheckout.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return &orderGroup.ID, nil
+}
+
+// ReconcileOrderHarvest implements Command.
+// when the budidaya is harvested the order that is not paid yet is canceled,
+// and the paid order is ready to be picked up or delivered
+func (c *command) ReconcileOrderHarvest(ctx context.Context, budidayaID uuid.UUID) (*model.ReconcileOrderOutput, error) {
+	var (
+		result = model.ReconcileOrderOutput{
+			Canceled: []uuid.UUID{},
+			Ready:    []uuid.UUID{},
+		}
+	)
+
+	orders, err := c.query.ReadOrderByBudidayaIDAndStatus(ctx, budidayaID, append([]string{model.PAID}, model.UnpaidStatus...))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range orders {
+		input := model.UpdateOrderStatusInput{
+			ID:     v.ID,
+			Status: model.CANCEL,
+			Reason: "the budidaya is harvested before the order is paid",
+			Source: model.SOURCE_HARVEST,
+		}
+		if v.Status == model.PAID {
+			input.Status = model.READY
+			input.Reason = "the budidaya is harvested"
+		}
+
+		_, err = c.UpdateOrderStatus(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		if input.Status == model.READY {
+			result.Ready = append(result.Ready, v.ID)
+		} else {
+			result.Canceled = append(result.Canceled, v.ID)
+		}
+	}
+
+	return &result, nil
+}
+
+// ReconcileOrderFailed implements Command.
+// when the budidaya is failed the order that is not paid yet is canceled and the paid order is refunded,
+// the order that is ready or disputed must be resolved first, so the budidaya can't be failed while it exists
+func (c *command) ReconcileOrderFailed(ctx context.Context, budidayaID uuid.UUID) (*model.ReconcileOrderOutput, error) {
+	var (
+		result = model.ReconcileOrderOutput{
+			Canceled: []uuid.UUID{},
+			Refunded: []uuid.UUID{},
+		}
+	)
+
+	pending, err := c.query.ReadOrderByBudidayaIDAndStatus(ctx, budidayaID, []string{model.READY, model.DISPUTED})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pending) > 0 {
+		return nil, errortransaction.ErrOrderInFulfillment.AttacthDetail(map[string]any{"budidayaID": budidayaID, "order": len(pending)})
+	}
+
+	orders, err := c.query.ReadOrderByBudidayaIDAndStatus(ctx, budidayaID, append([]string{model.PAID}, model.UnpaidStatus...))
+	if err != nil {
+		return nil, err
+	}
+
+	// the order is changed by the system, because the seller can't refund the order
+	ctx = ctxutil.SetUserAppType(ctx, model.SYSTEM)
+
+	for _, v := range orders {
+		input := model.UpdateOrderStatusInput{
+			ID:     v.ID,
+			Status: model.CANCEL,
+			Reason: "the budidaya is failed before the order is paid",
+			Source: model.SOURCE_BUDIDAYA_FAILED,
+		}
+		if v.Status == model.PAID {
+			input.Status = model.REFUNDED
+			input.Reason = "the budidaya is failed"
+		}
+
+		_, err = c.UpdateOrderStatus(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		if input.Status == model.REFUNDED {
+			result.Refunded = append(result.Refunded, v.ID)
+		} else {
+			result.Canceled = append(result.Canceled, v.ID)
+		}
+	}
+
+	return &result, nil
 }
 
 // Commit implements Command.
@@ -175,11 +844,19 @@ func (c *command) Commit(ctx context.Context) error {
 	if err := orm.CommitTxn(ctx); err != nil {
 		return errortransaction.ErrCommit.AttacthDetail(map[string]any{"errors": err})
 	}
+
+	// the refund of the command that share the transaction of the other command is left as refunding,
+	// and it is sent by the scheduler after the transaction is committed
+	if !orm.InTxn(ctx) {
+		c.sendRefund(ctx)
+	}
 	return nil
 }
 
 // Rollback implements Command.
 func (c *command) Rollback(ctx context.Context) error {
+	c.refunds = nil
+
 	if err := c.budidayaCommand.Rollback(ctx); err != nil {
 		return errortransaction.ErrRollback.AttacthDetail(map[string]any{"errors": err})
 	}
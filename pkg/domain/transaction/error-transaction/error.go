@@ -36,4 +36,109 @@ var (
 		Code:    "FailedUpdateOrderStatus",
 		Message: "failed update order status",
 	}
+
+	ErrValidateUpdateStatusInput = werror.Error{
+		Code:    "FailedValidateUpdateOrderStatusInput",
+		Message: "invalid update order status input",
+	}
+
+	ErrAccessOrder = werror.Error{
+		Code:    "FailedAccessOrder",
+		Message: "you are not allowed to update this order",
+	}
+
+	ErrCreateOrderStatusHistory = werror.Error{
+		Code:    "FailedCreateOrderStatusHistory",
+		Message: "failed create order status history",
+	}
+
+	ErrValidateCreatePaymentInput = werror.Error{
+		Code:    "FailedValidateCreatePaymentInput",
+		Message: "field can't by empty",
+	}
+
+	ErrCreatePayment = werror.Error{
+		Code:    "FailedCreatePayment",
+		Message: "failed create payment",
+	}
+
+	ErrFoundPayment = werror.Error{
+		Code:    "FailedFoundPayment",
+		Message: "payment not found",
+	}
+
+	ErrUpdatePayment = werror.Error{
+		Code:    "FailedUpdatePayment",
+		Message: "failed update payment",
+	}
+
+	ErrValidateUpdateInput = werror.Error{
+		Code:    "FailedValidateUpdateOrderInput",
+		Message: "field can't by empty",
+	}
+
+	ErrUpdateOrder = werror.Error{
+		Code:    "FailedUpdateOrder",
+		Message: "failed update order",
+	}
+
+	ErrValidateCartInput = werror.Error{
+		Code:    "FailedValidateCartInput",
+		Message: "invalid cart input",
+	}
+
+	ErrFoundCart = werror.Error{
+		Code:    "FailedFoundCart",
+		Message: "cart not found",
+	}
+
+	ErrReadCartData = werror.Error{
+		Code:    "FailedReadCartData",
+		Message: "unable to read cart data",
+	}
+
+	ErrUpdateCart = werror.Error{
+		Code:    "FailedUpdateCart",
+		Message: "failed update cart",
+	}
+
+	ErrValidateCheckoutInput = werror.Error{
+		Code:    "FailedValidateCheckoutInput",
+		Message: "field can't by empty",
+	}
+
+	ErrCheckout = werror.Error{
+		Code:    "FailedCheckout",
+		Message: "failed checkout the cart",
+	}
+
+	ErrInvoiceOrder = werror.Error{
+		Code:    "FailedInvoiceOrder",
+		Message: "the canceled order doesn't have an invoice",
+	}
+
+	ErrGenerateInvoice = werror.Error{
+		Code:    "FailedGenerateInvoice",
+		Message: "failed generate invoice",
+	}
+
+	ErrValidateSalesInput = werror.Error{
+		Code:    "FailedValidateSalesInput",
+		Message: "invalid sales analytics input",
+	}
+
+	ErrReadSalesData = werror.Error{
+		Code:    "FailedReadSalesData",
+		Message: "unable to read sales data",
+	}
+
+	ErrFoundUser = werror.Error{
+		Code:    "FailedFoundUser",
+		Message: "user not found",
+	}
+
+	ErrOrderInFulfillment = werror.Error{
+		Code:    "FailedOrderInFulfillment",
+		Message: "the budidaya has the order that is ready or disputed",
+	}
 )
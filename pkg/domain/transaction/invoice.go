@@ -0,0 +1,94 @@
+package transaction
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	errortransaction "github.com/e-fish/api/pkg/domain/transaction/error-transaction"
+	"github.com/e-fish/api/pkg/domain/transaction/model"
+	"github.com/go-pdf/fpdf"
+)
+
+// GenerateInvoicePDF render the invoice of the order into a pdf document
+func GenerateInvoicePDF(invoice model.Invoice) ([]byte, error) {
+	var (
+		pdf = fpdf.New("P", "mm", "A4", "")
+		tr  = pdf.UnicodeTranslatorFromDescriptor("")
+		buf bytes.Buffer
+	)
+
+	pdf.SetTitle(fmt.Sprintf("%v %v", invoice.Title, invoice.Code), true)
+	pdf.AddPage()
+
+	pdf.SetFont("Arial", "B", 18)
+	pdf.CellFormat(0, 10, invoice.Title, "", 1, "L", false, 0, "")
+
+	pdf.SetFont("Arial", "B", 12)
+	pdf.CellFormat(0, 7, tr(invoice.PondName), "", 1, "L", false, 0, "")
+	pdf.SetFont("Arial", "", 10)
+	pdf.MultiCell(0, 5, tr(invoice.PondAddress), "", "L", false)
+	pdf.Ln(4)
+
+	header := [][2]string{
+		{"Order Code", invoice.Code},
+		{"Date", invoice.Date.Format("02 Jan 2006")},
+		{"Buyer", invoice.Buyer},
+		{"Status", invoice.Status},
+	}
+	if invoice.BookingDate != nil {
+		header = append(header, [2]string{"Booking Date", invoice.BookingDate.Format("02 Jan 2006")})
+	}
+	for _, v := range header {
+		pdf.CellFormat(35, 6, v[0], "", 0, "L", false, 0, "")
+		pdf.CellFormat(0, 6, ": "+tr(v[1]), "", 1, "L", false, 0, "")
+	}
+	pdf.Ln(4)
+
+	widths := []float64{45, 45, 20, 25, 25, 30}
+	pdf.SetFont("Arial", "B", 10)
+	pdf.SetFillColor(230, 230, 230)
+	for i, v := range []string{"Fish", "Pool", "Qty (kg)", "Tier (kg)", "Price/kg", "Amount"} {
+		pdf.CellFormat(widths[i], 7, v, "1", 0, "C", true, 0, "")
+	}
+	pdf.Ln(-1)
+
+	pdf.SetFont("Arial", "", 10)
+	for _, v := range invoice.Items {
+		pdf.CellFormat(widths[0], 7, tr(v.FishSpecies), "1", 0, "L", false, 0, "")
+		pdf.CellFormat(widths[1], 7, tr(v.Pool), "1", 0, "L", false, 0, "")
+		pdf.CellFormat(widths[2], 7, strconv.Itoa(v.Qty), "1", 0, "R", false, 0, "")
+		pdf.CellFormat(widths[3], 7, fmt.Sprintf(">= %d", v.Limit), "1", 0, "R", false, 0, "")
+		pdf.CellFormat(widths[4], 7, formatRupiah(v.Price), "1", 0, "R", false, 0, "")
+		pdf.CellFormat(widths[5], 7, formatRupiah(v.Ammout), "1", 0, "R", false, 0, "")
+		pdf.Ln(-1)
+	}
+
+	pdf.SetFont("Arial", "B", 10)
+	pdf.CellFormat(widths[0]+widths[1]+widths[2]+widths[3]+widths[4], 7, "Total", "1", 0, "R", false, 0, "")
+	pdf.CellFormat(widths[5], 7, formatRupiah(invoice.Total), "1", 1, "R", false, 0, "")
+
+	err := pdf.Output(&buf)
+	if err != nil {
+		return nil, errortransaction.ErrGenerateInvoice.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return buf.Bytes(), nil
+}
+
+// formatRupiah format the price with the thousand separator, ex: Rp 1.500.000
+func formatRupiah(price float64) string {
+	var (
+		number = strconv.FormatInt(int64(price), 10)
+		result []string
+	)
+
+	for len(number) > 3 {
+		result = append([]string{number[len(number)-3:]}, result...)
+		number = number[:len(number)-3]
+	}
+	result = append([]string{number}, result...)
+
+	return "Rp " + strings.Join(result, ".")
+}
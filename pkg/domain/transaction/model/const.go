@@ -1,22 +1,139 @@
 package model
 
+import (
+	"time"
+
+	userModel "github.com/e-fish/api/pkg/domain/auth/model"
+)
+
+// order lifecycle
+// ACTIVE is the initial status, the order is waiting for the seller confirmation
 const (
-	ACTIVE  = "active"
-	CANCEL  = "cancel"
-	SUCCESS = "success"
+	ACTIVE           = "active"
+	CONFIRMED        = "confirmed"
+	AWAITING_PAYMENT = "awaiting payment"
+	PAID             = "paid"
+	READY            = "ready for harvest"
+	PICKED_UP        = "picked up"
+	DELIVERED        = "delivered"
+	SUCCESS          = "success"
+	CANCEL           = "cancel"
+	REFUNDED         = "refunded"
+	DISPUTED         = "disputed"
 )
 
+// source of the order status changes
+const (
+	SOURCE_API       = "api"
+	SOURCE_SCHEDULER = "scheduler"
+	SOURCE_PAYMENT   = "payment"
+	SOURCE_HARVEST   = "harvest"
+	// SOURCE_BUDIDAYA_FAILED is the order that is reconciled when the budidaya is failed
+	SOURCE_BUDIDAYA_FAILED = "budidaya failed"
+)
+
+// ReservationDuration is how long the qty of new order is held, before it is confirmed by the seller
+const ReservationDuration = 24 * time.Hour
+
+// PaymentExpiredDuration is how long the invoice of the order can be paid
+const PaymentExpiredDuration = 24 * time.Hour
+
+// SYSTEM is the actor used by the processes that run without user login, like the scheduler
+const SYSTEM = "system"
+
 var ValidateStatus = map[string]map[string]bool{
 	ACTIVE: {
-		CANCEL:  true,
-		SUCCESS: true,
+		CONFIRMED: true,
+		CANCEL:    true,
+		SUCCESS:   true,
+	},
+	CONFIRMED: {
+		AWAITING_PAYMENT: true,
+		CANCEL:           true,
+	},
+	AWAITING_PAYMENT: {
+		PAID:   true,
+		CANCEL: true,
+	},
+	PAID: {
+		READY:    true,
+		REFUNDED: true,
+		DISPUTED: true,
+	},
+	READY: {
+		PICKED_UP: true,
+		DELIVERED: true,
+		DISPUTED:  true,
 	},
-	CANCEL: {
-		ACTIVE:  false,
-		SUCCESS: false,
+	PICKED_UP: {
+		SUCCESS:  true,
+		DISPUTED: true,
 	},
-	SUCCESS: {
-		ACTIVE:  false,
-		SUCCESS: false,
+	DELIVERED: {
+		SUCCESS:  true,
+		DISPUTED: true,
 	},
+	DISPUTED: {
+		SUCCESS:  true,
+		REFUNDED: true,
+	},
+	SUCCESS:  {},
+	CANCEL:   {},
+	REFUNDED: {},
+}
+
+// ValidateActorStatus list the status that can be set by each actor
+var ValidateActorStatus = map[string]map[string]bool{
+	userModel.BUYER: {
+		CANCEL:    true,
+		PICKED_UP: true,
+		SUCCESS:   true,
+		DISPUTED:  true,
+	},
+	userModel.SELLER: {
+		CONFIRMED:        true,
+		AWAITING_PAYMENT: true,
+		READY:            true,
+		PICKED_UP:        true,
+		DELIVERED:        true,
+		SUCCESS:          true,
+		CANCEL:           true,
+	},
+	userModel.ADMIN: {
+		CONFIRMED:        true,
+		AWAITING_PAYMENT: true,
+		PAID:             true,
+		READY:            true,
+		PICKED_UP:        true,
+		DELIVERED:        true,
+		SUCCESS:          true,
+		CANCEL:           true,
+		REFUNDED:         true,
+		DISPUTED:         true,
+	},
+	SYSTEM: {
+		PAID:     true,
+		SUCCESS:  true,
+		CANCEL:   true,
+		REFUNDED: true,
+	},
+}
+
+// StockHeldStatus is the status where the qty of the order is still taken from the budidaya stock
+var StockHeldStatus = map[string]bool{
+	ACTIVE:           true,
+	CONFIRMED:        true,
+	AWAITING_PAYMENT: true,
+	PAID:             true,
+	READY:            true,
+}
+
+// UnpaidStatus is the status where the order can be canceled automatically
+var UnpaidStatus = []string{ACTIVE, CONFIRMED, AWAITING_PAYMENT}
+
+func CanUpdateStatus(actor, from, to string) bool {
+	if !ValidateStatus[from][to] {
+		return false
+	}
+	return ValidateActorStatus[actor][to]
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/e-fish/api/pkg/common/helper/rand"
 	"github.com/e-fish/api/pkg/common/helper/werror"
 	"github.com/e-fish/api/pkg/common/infra/orm"
+	"github.com/e-fish/api/pkg/common/infra/payment"
 	"github.com/e-fish/api/pkg/domain/budidaya/model"
 	errortransaction "github.com/e-fish/api/pkg/domain/transaction/error-transaction"
 	"github.com/google/uuid"
@@ -24,7 +25,7 @@ func (c *CreateOrderInput) Validate() error {
 	if c.BudidayaID == uuid.Nil {
 		errs.Add(errortransaction.ErrValidateCreateInput.AttacthDetail(map[string]any{"budidayaID": "empty"}))
 	}
-	if c.Qty == 0 {
+	if c.Qty <= 0 {
 		errs.Add(errortransaction.ErrValidateCreateInput.AttacthDetail(map[string]any{"qty": "empty"}))
 	}
 	if c.BookingDate == nil {
@@ -35,18 +36,115 @@ func (c *CreateOrderInput) Validate() error {
 }
 
 func (c *CreateOrderInput) ToOrder(userID uuid.UUID, pricelist model.PriceList) Order {
+	reservedUntil := time.Now().Add(ReservationDuration)
+
 	return Order{
+		ID:             uuid.New(),
+		Code:           GenerateCode(),
+		PondID:         pricelist.Budidaya.PondID,
+		BudidayaID:     c.BudidayaID,
+		UserID:         userID,
+		Qty:            c.Qty,
+		PricelistID:    pricelist.ID,
+		PricelistLimit: pricelist.Limit,
+		Price:          float64(pricelist.Price),
+		Ammout:         float64(pricelist.Price) * float64(c.Qty),
+		BookingDate:    c.BookingDate,
+		ReservedUntil:  &reservedUntil,
+		Status:         ACTIVE,
+		OrmModel: orm.OrmModel{
+			CreatedAt: time.Now(),
+			CreatedBy: userID,
+		},
+	}
+}
+
+type AddCartItemInput struct {
+	BudidayaID uuid.UUID `json:"budidayaID"`
+	Qty        int       `json:"qty"`
+}
+
+func (a *AddCartItemInput) Validate() error {
+	errs := werror.NewError("failed validate error")
+
+	if a.BudidayaID == uuid.Nil {
+		errs.Add(errortransaction.ErrValidateCartInput.AttacthDetail(map[string]any{"budidayaID": "empty"}))
+	}
+	if a.Qty <= 0 {
+		errs.Add(errortransaction.ErrValidateCartInput.AttacthDetail(map[string]any{"qty": "empty"}))
+	}
+
+	return errs.Return()
+}
+
+func (a *AddCartItemInput) ToCartItem(userID, cartID uuid.UUID) CartItem {
+	return CartItem{
+		ID:         uuid.New(),
+		CartID:     cartID,
+		BudidayaID: a.BudidayaID,
+		Qty:        a.Qty,
+		OrmModel: orm.OrmModel{
+			CreatedAt: time.Now(),
+			CreatedBy: userID,
+		},
+	}
+}
+
+func NewCart(userID, pondID uuid.UUID) Cart {
+	return Cart{
+		ID:     uuid.New(),
+		UserID: userID,
+		PondID: pondID,
+		OrmModel: orm.OrmModel{
+			CreatedAt: time.Now(),
+			CreatedBy: userID,
+		},
+	}
+}
+
+type UpdateCartItemInput struct {
+	ID  uuid.UUID `json:"id"`
+	Qty int       `json:"qty"`
+}
+
+func (u *UpdateCartItemInput) Validate() error {
+	errs := werror.NewError("failed validate error")
+
+	if u.ID == uuid.Nil {
+		errs.Add(errortransaction.ErrValidateCartInput.AttacthDetail(map[string]any{"id": "empty"}))
+	}
+	if u.Qty < 0 {
+		errs.Add(errortransaction.ErrValidateCartInput.AttacthDetail(map[string]any{"qty": "negative"}))
+	}
+
+	return errs.Return()
+}
+
+type CheckoutInput struct {
+	CartID      uuid.UUID  `json:"cartID"`
+	BookingDate *time.Time `json:"bookingDate"`
+}
+
+func (c *CheckoutInput) Validate() error {
+	errs := werror.NewError("failed validate error")
+
+	if c.CartID == uuid.Nil {
+		errs.Add(errortransaction.ErrValidateCheckoutInput.AttacthDetail(map[string]any{"cartID": "empty"}))
+	}
+	if c.BookingDate == nil {
+		errs.Add(errortransaction.ErrValidateCheckoutInput.AttacthDetail(map[string]any{"bookingDate": "empty"}))
+	}
+
+	return errs.Return()
+}
+
+func (c *CheckoutInput) ToOrderGroup(userID, pondID uuid.UUID) OrderGroup {
+	return OrderGroup{
 		ID:          uuid.New(),
 		Code:        GenerateCode(),
-		PondID:      pricelist.Budidaya.PondID,
-		BudidayaID:  c.BudidayaID,
 		UserID:      userID,
-		Qty:         c.Qty,
-		PricelistID: pricelist.ID,
-		Price:       float64(pricelist.Price),
-		Ammout:      float64(pricelist.Price) * float64(c.Qty),
+		PondID:      pondID,
 		BookingDate: c.BookingDate,
-		Status:      ACTIVE,
 		OrmModel: orm.OrmModel{
 			CreatedAt: time.Now(),
 			CreatedBy: userID,
@@ -54,6 +152,142 @@ func (c *CreateOrderInput) ToOrder(userID uuid.UUID, pricelist model.PriceList)
 	}
 }
 
+type UpdateOrderInput struct {
+	ID          uuid.UUID  `json:"id"`
+	Qty         int        `json:"qty"`
+	BookingDate *time.Time `json:"bookingDate"`
+}
+
+func (u *UpdateOrderInput) Validate() error {
+	errs := werror.NewError("failed validate error")
+
+	if u.ID == uuid.Nil {
+		errs.Add(errortransaction.ErrValidateUpdateInput.AttacthDetail(map[string]any{"id": "empty"}))
+	}
+	// the booking date is kept when it is empty, so the qty can be changed alone
+	if u.Qty <= 0 {
+		errs.Add(errortransaction.ErrValidateUpdateInput.AttacthDetail(map[string]any{"qty": "empty"}))
+	}
+
+	return errs.Return()
+}
+
+// ToOrderStatusHistory record the change of the order, the status of the order is not changed
+func (u *UpdateOrderInput) ToOrderStatusHistory(userID uuid.UUID, actor string, exist OrderOutput) OrderStatusHistory {
+	reason := fmt.Sprintf("the order is updated, qty %d to %d", exist.Qty, u.Qty)
+	if u.BookingDate != nil {
+		reason = fmt.Sprintf("%s, booking date %s", reason, u.BookingDate.Format("2006-01-02"))
+	}
+
+	return OrderStatusHistory{
+		ID:             uuid.New(),
+		OrderID:        u.ID,
+		PreviousStatus: exist.Status,
+		Status:         exist.Status,
+		Actor:          actor,
+		Source:         SOURCE_API,
+		Reason:         reason,
+		OrmModel: orm.OrmModel{
+			CreatedAt: time.Now(),
+			CreatedBy: userID,
+		},
+	}
+}
+
+func (u *UpdateOrderInput) ToOrder(userID uuid.UUID, pricelist model.PriceList) Order {
+	today := time.Now()
+
+	return Order{
+		Qty:            u.Qty,
+		BookingDate:    u.BookingDate,
+		PricelistID:    pricelist.ID,
+		PricelistLimit: pricelist.Limit,
+		Price:          float64(pricelist.Price),
+		Ammout:         float64(pricelist.Price) * float64(u.Qty),
+		OrmModel: orm.OrmModel{
+			UpdatedAt: &today,
+			UpdatedBy: &userID,
+		},
+	}
+}
+
+type UpdateOrderStatusInput struct {
+	ID     uuid.UUID `json:"id"`
+	Status string    `json:"status"`
+	Reason string    `json:"reason"`
+	Source string    `json:"-"`
+}
+
+func (u *UpdateOrderStatusInput) Validate() error {
+	errs := werror.NewError("failed validate error")
+
+	if u.ID == uuid.Nil {
+		errs.Add(errortransaction.ErrValidateUpdateStatusInput.AttacthDetail(map[string]any{"id": "empty"}))
+	}
+	if _, ok := ValidateStatus[u.Status]; !ok {
+		errs.Add(errortransaction.ErrValidateUpdateStatusInput.AttacthDetail(map[string]any{"status": "unknown"}))
+	}
+
+	return errs.Return()
+}
+
+func (u *UpdateOrderStatusInput) ToOrderStatusHistory(userID uuid.UUID, actor, previousStatus string) OrderStatusHistory {
+	source := u.Source
+	if source == "" {
+		source = SOURCE_API
+	}
+
+	return OrderStatusHistory{
+		ID:             uuid.New(),
+		OrderID:        u.ID,
+		PreviousStatus: previousStatus,
+		Status:         u.Status,
+		Actor:          actor,
+		Source:         source,
+		Reason:         u.Reason,
+		OrmModel: orm.OrmModel{
+			CreatedAt: time.Now(),
+			CreatedBy: userID,
+		},
+	}
+}
+
+type CreatePaymentInput struct {
+	OrderID uuid.UUID `json:"orderID"`
+}
+
+func (c *CreatePaymentInput) Validate() error {
+	errs := werror.NewError("failed validate error")
+
+	if c.OrderID == uuid.Nil {
+		errs.Add(errortransaction.ErrValidateCreatePaymentInput.AttacthDetail(map[string]any{"orderID": "empty"}))
+	}
+
+	return errs.Return()
+}
+
+func (c *CreatePaymentInput) ToPayment(userID uuid.UUID, provider string, invoice payment.Invoice) Payment {
+	return Payment{
+		ID:         uuid.New(),
+		OrderID:    c.OrderID,
+		Provider:   provider,
+		ExternalID: invoice.ExternalID,
+		Amount:     invoice.Amount,
+		Status:     invoice.Status,
+		PaymentUrl: invoice.PaymentUrl,
+		ExpiredAt:  invoice.ExpiredAt,
+		OrmModel: orm.OrmModel{
+			CreatedAt: time.Now(),
+			CreatedBy: userID,
+		},
+	}
+}
+
+type PaymentCallbackInput struct {
+	Signature string
+	Body      []byte
+}
+
 func GenerateCode() string {
 	today := time.Now()
 	year := today.Year()
@@ -0,0 +1,112 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	pondModel "github.com/e-fish/api/pkg/domain/pond/model"
+)
+
+// title of the invoice document,
+// the order that is not paid yet get an invoice and the paid order get a receipt
+const (
+	INVOICE = "INVOICE"
+	RECEIPT = "RECEIPT"
+)
+
+type Invoice struct {
+	Title       string
+	Code        string
+	Status      string
+	Date        time.Time
+	BookingDate *time.Time
+	PondName    string
+	PondAddress string
+	Buyer       string
+	Items       []InvoiceItem
+	Total       float64
+}
+
+type InvoiceItem struct {
+	Code        string
+	FishSpecies string
+	Pool        string
+	Qty         int
+	Limit       int
+	Price       float64
+	Ammout      float64
+}
+
+// NewInvoice create the invoice from the orders, the orders is more than one when it is created by the checkout
+func NewInvoice(code string, orders []*OrderOutput, pond *pondModel.PondOutput) Invoice {
+	invoice := Invoice{
+		Title:       RECEIPT,
+		Code:        code,
+		PondName:    pond.Name,
+		PondAddress: PondAddress(pond),
+	}
+
+	for _, v := range orders {
+		for _, status := range UnpaidStatus {
+			if v.Status == status {
+				invoice.Title = INVOICE
+			}
+		}
+
+		item := InvoiceItem{
+			Code:   v.Code,
+			Qty:    v.Qty,
+			Limit:  v.PricelistLimit,
+			Price:  v.Price,
+			Ammout: v.Ammout,
+		}
+		if v.Budidaya != nil {
+			item.FishSpecies = v.Budidaya.FishSpeciesName
+			if v.Budidaya.FishSpecies != nil {
+				item.FishSpecies = v.Budidaya.FishSpecies.Name
+			}
+			if v.Budidaya.Pool != nil {
+				item.Pool = v.Budidaya.Pool.Name
+			}
+		}
+		// the order before the snapshot read the limit from the pricelist
+		if item.Limit == 0 && v.Pricelist != nil {
+			item.Limit = v.Pricelist.Limit
+		}
+
+		invoice.Items = append(invoice.Items, item)
+		invoice.Total += v.Ammout
+	}
+
+	if len(orders) > 0 {
+		invoice.Status = orders[0].Status
+		invoice.Date = orders[0].CreatedAt
+		invoice.BookingDate = orders[0].BookingDate
+		if orders[0].User != nil {
+			invoice.Buyer = orders[0].User.Name
+		}
+	}
+
+	return invoice
+}
+
+// PondAddress join the detail address with the region of the pond
+func PondAddress(pond *pondModel.PondOutput) string {
+	address := []string{}
+	if pond.DetailAddress != "" {
+		address = append(address, pond.DetailAddress)
+	}
+	if pond.District != nil {
+		address = append(address, pond.District.Name)
+	}
+	if pond.City != nil {
+		address = append(address, pond.City.Name)
+	}
+	if pond.Province != nil {
+		address = append(address, pond.Province.Name)
+	}
+	if pond.Country != nil {
+		address = append(address, pond.Country.Name)
+	}
+	return strings.Join(address, ", ")
+}
@@ -0,0 +1,74 @@
+package model_test
+
+import (
+	"testing"
+
+	budidayaModel "github.com/e-fish/api/pkg/domain/budidaya/model"
+	pondModel "github.com/e-fish/api/pkg/domain/pond/model"
+	regionModel "github.com/e-fish/api/pkg/domain/region/model"
+	"github.com/e-fish/api/pkg/domain/transaction/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewInvoice(t *testing.T) {
+	pond := &pondModel.PondOutput{
+		Name:          "Kolam Sejahtera",
+		DetailAddress: "Jl. Mawar 1",
+		City:          &regionModel.CityOutput{Name: "Bogor"},
+		Province:      &regionModel.ProvinceOutput{Name: "Jawa Barat"},
+	}
+
+	orders := []*model.OrderOutput{
+		{
+			Code:      "OC-1",
+			Qty:       10,
+			Price:     20000,
+			Ammout:    200000,
+			Status:    model.PAID,
+			Budidaya:  &budidayaModel.BudidayaOutput{FishSpecies: &budidayaModel.FishSpeciesOutput{Name: "Lele"}, Pool: &pondModel.PoolOutput{Name: "A1"}},
+			Pricelist: &budidayaModel.PriceListOutput{Limit: 10, Price: 20000},
+			User:      &model.User{Name: "Budi"},
+		},
+		{
+			Code:      "OC-2",
+			Qty:       5,
+			Price:     25000,
+			Ammout:    125000,
+			Status:    model.PAID,
+			Pricelist: &budidayaModel.PriceListOutput{Limit: 1, Price: 25000},
+		},
+	}
+
+	t.Run("Receipt", func(t *testing.T) {
+		invoice := model.NewInvoice("OG-1", orders, pond)
+
+		assert.Equal(t, model.RECEIPT, invoice.Title)
+		assert.Equal(t, "OG-1", invoice.Code)
+		assert.Equal(t, "Jl. Mawar 1, Bogor, Jawa Barat", invoice.PondAddress)
+		assert.Equal(t, "Budi", invoice.Buyer)
+		assert.Equal(t, float64(325000), invoice.Total)
+		assert.Len(t, invoice.Items, 2)
+		assert.Equal(t, "Lele", invoice.Items[0].FishSpecies)
+		assert.Equal(t, "A1", invoice.Items[0].Pool)
+		assert.Equal(t, 10, invoice.Items[0].Limit)
+	})
+
+	t.Run("Snapshot", func(t *testing.T) {
+		priced := *orders[0]
+		priced.PricelistLimit = 5
+
+		invoice := model.NewInvoice(priced.Code, []*model.OrderOutput{&priced}, pond)
+
+		assert.Equal(t, 5, invoice.Items[0].Limit)
+	})
+
+	t.Run("Invoice", func(t *testing.T) {
+		unpaid := *orders[0]
+		unpaid.Status = model.AWAITING_PAYMENT
+
+		invoice := model.NewInvoice(unpaid.Code, []*model.OrderOutput{&unpaid}, pond)
+
+		assert.Equal(t, model.INVOICE, invoice.Title)
+		assert.Equal(t, float64(200000), invoice.Total)
+	})
+}
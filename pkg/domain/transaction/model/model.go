@@ -19,20 +19,111 @@ func (u *User) TableName() string {
 }
 
 type Order struct {
+	ID            uuid.UUID `gorm:"primaryKey,size:256"`
+	Code          string
+	OrderGroupID  *uuid.UUID `gorm:"size:256"`
+	PondID        uuid.UUID
+	Pond          pondModel.Pond
+	BudidayaID    uuid.UUID
+	Budidaya      model.Budidaya
+	UserID        uuid.UUID
+	User          User
+	Qty           int
+	BookingDate   *time.Time
+	ReservedUntil *time.Time
+	PricelistID   uuid.UUID
+	Pricelist     model.PriceList
+	// PricelistLimit and Price is the snapshot of the tier when the order is priced
+	PricelistLimit int
+	Price          float64
+	Ammout         float64
+	Status         string
+	orm.OrmModel
+}
+
+func (o *Order) ToOrderStatusHistory(userID uuid.UUID, actor string) OrderStatusHistory {
+	return OrderStatusHistory{
+		ID:      uuid.New(),
+		OrderID: o.ID,
+		Status:  o.Status,
+		Actor:   actor,
+		Source:  SOURCE_API,
+		OrmModel: orm.OrmModel{
+			CreatedAt: time.Now(),
+			CreatedBy: userID,
+		},
+	}
+}
+
+type OrderStatusHistory struct {
+	ID             uuid.UUID `gorm:"primaryKey,size:256"`
+	OrderID        uuid.UUID `gorm:"size:256"`
+	Order          Order
+	PreviousStatus string
+	Status         string
+	Actor          string
+	Source         string
+	Reason         string
+	orm.OrmModel
+}
+
+type Payment struct {
+	ID         uuid.UUID `gorm:"primaryKey,size:256"`
+	OrderID    uuid.UUID `gorm:"size:256"`
+	Order      Order
+	Provider   string
+	ExternalID string
+	Amount     float64
+	Status     string
+	PaymentUrl string
+	ExpiredAt  time.Time
+	PaidAt     *time.Time
+	RefundID   string
+	orm.OrmModel
+}
+
+func (p *Payment) ToPaymentOutput() *PaymentOutput {
+	return &PaymentOutput{
+		ID:         p.ID,
+		OrderID:    p.OrderID,
+		Provider:   p.Provider,
+		ExternalID: p.ExternalID,
+		Amount:     p.Amount,
+		Status:     p.Status,
+		PaymentUrl: p.PaymentUrl,
+		ExpiredAt:  p.ExpiredAt,
+		PaidAt:     p.PaidAt,
+		CreatedAt:  p.CreatedAt,
+	}
+}
+
+// OrderGroup is the parent of the orders created from one checkout,
+// every budidaya in the cart become one order
+type OrderGroup struct {
 	ID          uuid.UUID `gorm:"primaryKey,size:256"`
 	Code        string
-	PondID      uuid.UUID
-	Pond        pondModel.Pond
-	BudidayaID  uuid.UUID
-	Budidaya    model.Budidaya
-	UserID      uuid.UUID
-	User        User
-	Qty         int
+	UserID      uuid.UUID `gorm:"size:256"`
+	PondID      uuid.UUID `gorm:"size:256"`
 	BookingDate *time.Time
-	PricelistID uuid.UUID
-	Pricelist   model.PriceList
-	Price       float64
 	Ammout      float64
-	Status      string
+	Orders      []*Order
+	orm.OrmModel
+}
+
+type Cart struct {
+	ID     uuid.UUID `gorm:"primaryKey,size:256"`
+	UserID uuid.UUID `gorm:"size:256"`
+	PondID uuid.UUID `gorm:"size:256"`
+	Items  []*CartItem
+	orm.OrmModel
+}
+
+type CartItem struct {
+	ID         uuid.UUID `gorm:"primaryKey,size:256"`
+	CartID     uuid.UUID `gorm:"size:256"`
+	Cart       Cart
+	BudidayaID uuid.UUID `gorm:"size:256"`
+	Budidaya   model.Budidaya
+	Qty        int
 	orm.OrmModel
 }
@@ -0,0 +1,43 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	userModel "github.com/e-fish/api/pkg/domain/auth/model"
+	"github.com/e-fish/api/pkg/domain/transaction/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateOrderInput(t *testing.T) {
+	bookingDate := time.Now()
+	input := model.CreateOrderInput{BudidayaID: uuid.New(), Qty: 10, BookingDate: &bookingDate}
+	assert.NoError(t, input.Validate())
+
+	input.Qty = 0
+	assert.Error(t, input.Validate())
+
+	input.Qty = -5
+	assert.Error(t, input.Validate())
+}
+
+func TestUpdateOrderInput(t *testing.T) {
+	input := model.UpdateOrderInput{ID: uuid.New(), Qty: 15}
+	assert.NoError(t, input.Validate())
+
+	exist := model.OrderOutput{ID: input.ID, Qty: 10, Status: model.ACTIVE}
+
+	history := input.ToOrderStatusHistory(uuid.New(), userModel.BUYER, exist)
+	assert.Equal(t, model.ACTIVE, history.PreviousStatus)
+	assert.Equal(t, model.ACTIVE, history.Status)
+	assert.Equal(t, "the order is updated, qty 10 to 15", history.Reason)
+
+	bookingDate := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+	input.BookingDate = &bookingDate
+	history = input.ToOrderStatusHistory(uuid.New(), userModel.BUYER, exist)
+	assert.Equal(t, "the order is updated, qty 10 to 15, booking date 2023-07-01", history.Reason)
+
+	input.Qty = 0
+	assert.Error(t, input.Validate())
+}
@@ -4,24 +4,30 @@ import (
 	"time"
 
 	"github.com/e-fish/api/pkg/domain/budidaya/model"
+	pondModel "github.com/e-fish/api/pkg/domain/pond/model"
 	"github.com/google/uuid"
 )
 
 type OrderOutput struct {
-	ID          uuid.UUID              `gorm:"primaryKey,size:256" json:"id"`
-	Code        string                 `json:"code"`
-	BudidayaID  uuid.UUID              `json:"budidayaID"`
-	Budidaya    *model.BudidayaOutput  `gorm:"foreignKey:BudidayaID;references:ID" json:"budidaya,omitempty"`
-	UserID      uuid.UUID              `json:"-"`
-	User        *User                  `gorm:"foreignKey:UserID;references:ID" json:"user,omitempty"`
-	Qty         int                    `json:"qty"`
-	BookingDate *time.Time             `json:"bookingDate"`
-	PricelistID uuid.UUID              `json:"pricelistID"`
-	Pricelist   *model.PriceListOutput `gorm:"foreignKey:PricelistID;references:ID" json:"pricelist,omitempty"`
-	Price       float64                `json:"price"`
-	Ammout      float64                `json:"ammout"`
-	Status      string                 `json:"status"`
-	CreatedAt   time.Time              `json:"createdAt"`
+	ID            uuid.UUID             `gorm:"primaryKey,size:256" json:"id"`
+	Code          string                `json:"code"`
+	PondID        uuid.UUID             `json:"pondID"`
+	OrderGroupID  *uuid.UUID            `json:"orderGroupID,omitempty"`
+	BudidayaID    uuid.UUID             `json:"budidayaID"`
+	Budidaya      *model.BudidayaOutput `gorm:"foreignKey:BudidayaID;references:ID" json:"budidaya,omitempty"`
+	UserID        uuid.UUID             `json:"-"`
+	User          *User                 `gorm:"foreignKey:UserID;references:ID" json:"user,omitempty"`
+	Qty           int                   `json:"qty"`
+	BookingDate   *time.Time            `json:"bookingDate"`
+	ReservedUntil *time.Time            `json:"reservedUntil,omitempty"`
+
+	PricelistID    uuid.UUID              `json:"pricelistID"`
+	Pricelist      *model.PriceListOutput `gorm:"foreignKey:PricelistID;references:ID" json:"pricelist,omitempty"`
+	PricelistLimit int                    `json:"pricelistLimit"`
+	Price          float64                `json:"price"`
+	Ammout         float64                `json:"ammout"`
+	Status         string                 `json:"status"`
+	CreatedAt      time.Time              `json:"createdAt"`
 }
 
 func (*OrderOutput) TableName() string {
@@ -39,3 +45,67 @@ type OrderOutputPagination struct {
 	TotalPage int    `json:"totalPage"`
 	Rows      any    `json:"rows"`
 }
+
+type OrderStatusHistoryOutput struct {
+	ID             uuid.UUID `gorm:"primaryKey,size:256" json:"id"`
+	OrderID        uuid.UUID `json:"orderID"`
+	PreviousStatus string    `json:"previousStatus"`
+	Status         string    `json:"status"`
+	Actor          string    `json:"actor"`
+	Source         string    `json:"source"`
+	Reason         string    `json:"reason"`
+	CreatedBy      uuid.UUID `json:"createdBy"`
+	User           *User     `gorm:"foreignKey:CreatedBy;references:ID" json:"user,omitempty"`
+	CreatedAt      time.Time `json:"createdAt"`
+}
+
+func (*OrderStatusHistoryOutput) TableName() string {
+	return "order_status_histories"
+}
+
+type PaymentOutput struct {
+	ID         uuid.UUID  `gorm:"primaryKey,size:256" json:"id"`
+	OrderID    uuid.UUID  `json:"orderID"`
+	Provider   string     `json:"provider"`
+	ExternalID string     `json:"externalID"`
+	Amount     float64    `json:"amount"`
+	Status     string     `json:"status"`
+	PaymentUrl string     `json:"paymentUrl"`
+	ExpiredAt  time.Time  `json:"expiredAt"`
+	PaidAt     *time.Time `json:"paidAt"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+func (*PaymentOutput) TableName() string {
+	return "payments"
+}
+
+type CartOutput struct {
+	ID     uuid.UUID             `gorm:"primaryKey,size:256" json:"id"`
+	PondID uuid.UUID             `json:"pondID"`
+	Pond   *pondModel.PondOutput `gorm:"foreignKey:PondID;references:ID" json:"pond,omitempty"`
+	Items  []*CartItemOutput     `gorm:"foreignKey:CartID;references:ID" json:"items"`
+}
+
+func (*CartOutput) TableName() string {
+	return "carts"
+}
+
+type CartItemOutput struct {
+	ID         uuid.UUID             `gorm:"primaryKey,size:256" json:"id"`
+	CartID     uuid.UUID             `json:"cartID"`
+	BudidayaID uuid.UUID             `json:"budidayaID"`
+	Budidaya   *model.BudidayaOutput `gorm:"foreignKey:BudidayaID;references:ID" json:"budidaya,omitempty"`
+	Qty        int                   `json:"qty"`
+}
+
+func (*CartItemOutput) TableName() string {
+	return "cart_items"
+}
+
+// ReconcileOrderOutput is the orders of the budidaya that is changed by the harvest or the failed budidaya
+type ReconcileOrderOutput struct {
+	Canceled []uuid.UUID `json:"canceled"`
+	Ready    []uuid.UUID `json:"ready,omitempty"`
+	Refunded []uuid.UUID `json:"refunded,omitempty"`
+}
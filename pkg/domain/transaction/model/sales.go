@@ -0,0 +1,111 @@
+package model
+
+import (
+	"time"
+
+	"github.com/e-fish/api/pkg/common/helper/werror"
+	errortransaction "github.com/e-fish/api/pkg/domain/transaction/error-transaction"
+	"github.com/google/uuid"
+)
+
+// granularity of the sales revenue period
+const (
+	DAILY   = "daily"
+	MONTHLY = "monthly"
+	YEARLY  = "yearly"
+)
+
+// DATE_FORMAT is the format of the date range in the sales analytics
+const DATE_FORMAT = "2006-01-02"
+
+// SalesStatus is the status of the order that is counted as sales
+var SalesStatus = []string{PAID, READY, PICKED_UP, DELIVERED, SUCCESS}
+
+var validGranularity = map[string]bool{
+	DAILY:   true,
+	MONTHLY: true,
+	YEARLY:  true,
+}
+
+type SalesInput struct {
+	StartDate   time.Time `json:"startDate"`
+	EndDate     time.Time `json:"endDate"`
+	Granularity string    `json:"granularity"`
+	Limit       int       `json:"limit"`
+	// PondID is only used by admin, seller always see the sales of his pond
+	PondID uuid.UUID `json:"pondID"`
+}
+
+// Validate check the date range and set the default value,
+// the default range is the last 12 months with monthly granularity
+func (s *SalesInput) Validate() error {
+	errs := werror.NewError("error validate input")
+
+	if s.EndDate.IsZero() {
+		s.EndDate = time.Now()
+	}
+	if s.StartDate.IsZero() {
+		s.StartDate = s.EndDate.AddDate(-1, 0, 0)
+	}
+	if s.Granularity == "" {
+		s.Granularity = MONTHLY
+	}
+	if s.Limit < 1 {
+		s.Limit = 10
+	}
+
+	if s.StartDate.After(s.EndDate) {
+		errs.Add(errortransaction.ErrValidateSalesInput.AttacthDetail(map[string]any{"startDate": "must be before endDate"}))
+	}
+	if !validGranularity[s.Granularity] {
+		errs.Add(errortransaction.ErrValidateSalesInput.AttacthDetail(map[string]any{"granularity": "must be daily, monthly or yearly"}))
+	}
+
+	return errs.Return()
+}
+
+type SalesRevenueOutput struct {
+	Year       int     `json:"year"`
+	Month      int     `json:"month,omitempty"`
+	Day        int     `json:"day,omitempty"`
+	TotalOrder int     `json:"totalOrder"`
+	Qty        int     `json:"qty"`
+	Revenue    float64 `json:"revenue"`
+}
+
+type SalesFishSpeciesOutput struct {
+	FishSpeciesID   uuid.UUID `json:"fishSpeciesID"`
+	FishSpeciesName string    `json:"fishSpeciesName"`
+	TotalOrder      int       `json:"totalOrder"`
+	Qty             int       `json:"qty"`
+	Revenue         float64   `json:"revenue"`
+	AveragePrice    float64   `json:"averagePrice"`
+}
+
+type SalesTopBuyerOutput struct {
+	UserID     uuid.UUID `json:"userID"`
+	Name       string    `json:"name"`
+	TotalOrder int       `json:"totalOrder"`
+	Qty        int       `json:"qty"`
+	Revenue    float64   `json:"revenue"`
+}
+
+type SalesSummaryOutput struct {
+	TotalOrder       int     `json:"totalOrder"`
+	TotalSales       int     `json:"totalSales"`
+	TotalCancel      int     `json:"totalCancel"`
+	CancellationRate float64 `json:"cancellationRate"`
+	Qty              int     `json:"qty"`
+	Revenue          float64 `json:"revenue"`
+	AveragePrice     float64 `json:"averagePrice"`
+}
+
+// Calculate set the cancellation rate and the average price per kg
+func (s *SalesSummaryOutput) Calculate() {
+	if s.TotalOrder > 0 {
+		s.CancellationRate = float64(s.TotalCancel) / float64(s.TotalOrder)
+	}
+	if s.Qty > 0 {
+		s.AveragePrice = s.Revenue / float64(s.Qty)
+	}
+}
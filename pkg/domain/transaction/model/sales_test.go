@@ -0,0 +1,54 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/e-fish/api/pkg/domain/transaction/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSalesInputValidate(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		input := model.SalesInput{}
+
+		assert.NoError(t, input.Validate())
+		assert.Equal(t, model.MONTHLY, input.Granularity)
+		assert.Equal(t, 10, input.Limit)
+		assert.Equal(t, input.EndDate.AddDate(-1, 0, 0), input.StartDate)
+	})
+
+	t.Run("InvalidRange", func(t *testing.T) {
+		input := model.SalesInput{
+			StartDate: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+			EndDate:   time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC),
+		}
+
+		assert.Error(t, input.Validate())
+	})
+
+	t.Run("InvalidGranularity", func(t *testing.T) {
+		input := model.SalesInput{Granularity: "weekly"}
+
+		assert.Error(t, input.Validate())
+	})
+}
+
+func TestSalesSummaryCalculate(t *testing.T) {
+	summary := model.SalesSummaryOutput{
+		TotalOrder:  10,
+		TotalCancel: 2,
+		Qty:         50,
+		Revenue:     1000000,
+	}
+	summary.Calculate()
+
+	assert.Equal(t, 0.2, summary.CancellationRate)
+	assert.Equal(t, float64(20000), summary.AveragePrice)
+
+	empty := model.SalesSummaryOutput{}
+	empty.Calculate()
+
+	assert.Equal(t, float64(0), empty.CancellationRate)
+	assert.Equal(t, float64(0), empty.AveragePrice)
+}
@@ -0,0 +1,38 @@
+package model_test
+
+import (
+	"testing"
+
+	userModel "github.com/e-fish/api/pkg/domain/auth/model"
+	"github.com/e-fish/api/pkg/domain/transaction/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanUpdateStatus(t *testing.T) {
+	type Args struct {
+		Name   string
+		Actor  string
+		From   string
+		To     string
+		Expect bool
+	}
+
+	args := []Args{
+		{Name: "SellerConfirmOrder", Actor: userModel.SELLER, From: model.ACTIVE, To: model.CONFIRMED, Expect: true},
+		{Name: "BuyerCannotConfirmOrder", Actor: userModel.BUYER, From: model.ACTIVE, To: model.CONFIRMED, Expect: false},
+		{Name: "BuyerCancelOrder", Actor: userModel.BUYER, From: model.AWAITING_PAYMENT, To: model.CANCEL, Expect: true},
+		{Name: "SellerCannotMarkPaid", Actor: userModel.SELLER, From: model.AWAITING_PAYMENT, To: model.PAID, Expect: false},
+		{Name: "SystemMarkPaid", Actor: model.SYSTEM, From: model.AWAITING_PAYMENT, To: model.PAID, Expect: true},
+		{Name: "CannotCancelPaidOrder", Actor: userModel.ADMIN, From: model.PAID, To: model.CANCEL, Expect: false},
+		{Name: "AdminRefundDisputedOrder", Actor: userModel.ADMIN, From: model.DISPUTED, To: model.REFUNDED, Expect: true},
+		{Name: "SellerCannotRefund", Actor: userModel.SELLER, From: model.DISPUTED, To: model.REFUNDED, Expect: false},
+		{Name: "FinalStatus", Actor: userModel.ADMIN, From: model.SUCCESS, To: model.DISPUTED, Expect: false},
+		{Name: "UnknownActor", Actor: "", From: model.ACTIVE, To: model.CANCEL, Expect: false},
+	}
+
+	for _, v := range args {
+		t.Run(v.Name, func(t *testing.T) {
+			assert.Equal(t, v.Expect, model.CanUpdateStatus(v.Actor, v.From, v.To))
+		})
+	}
+}
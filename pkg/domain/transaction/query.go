@@ -3,10 +3,15 @@ package transaction
 import (
 	"context"
 	"errors"
+	"strings"
+	"time"
 
 	"github.com/e-fish/api/pkg/common/helper/ctxutil"
 	"github.com/e-fish/api/pkg/common/infra/orm"
 	userModel "github.com/e-fish/api/pkg/domain/auth/model"
+	"github.com/e-fish/api/pkg/domain/budidaya"
+	"github.com/e-fish/api/pkg/domain/pond"
+	pondModel "github.com/e-fish/api/pkg/domain/pond/model"
 	errortransaction "github.com/e-fish/api/pkg/domain/transaction/error-transaction"
 	"github.com/e-fish/api/pkg/domain/transaction/model"
 	"github.com/google/uuid"
@@ -14,9 +19,10 @@ import (
 	"gorm.io/gorm/clause"
 )
 
-func newQuery(db *gorm.DB) Query {
+func newQuery(db *gorm.DB, pondRepo pond.Repo) Query {
 	return &query{
-		db: db,
+		db:        db,
+		pondQuery: pondRepo.NewQuery(),
 	}
 }
 
@@ -24,23 +30,60 @@ func newQuery(db *gorm.DB) Query {
 // lock table row to avoid race condition
 func (q *query) lock() Query {
 	db := q.db.Clauses(clause.Locking{Strength: "UPDATE"})
-	return &query{db: db}
+	return &query{db: db, pondQuery: q.pondQuery}
+}
+
+// checkPondSeller implements Query.
+// the seller is checked with the team of the pond on every request instead of trusting the pond of the token
+func (q *query) checkPondSeller(ctx context.Context, action string) error {
+	var (
+		pondID, _  = ctxutil.GetPondID(ctx)
+		appType, _ = ctxutil.GetUserAppType(ctx)
+	)
+
+	if appType != userModel.SELLER {
+		return nil
+	}
+
+	_, err := q.pondQuery.CheckPondPermission(ctx, pondID, action)
+	return err
 }
 
 type query struct {
-	db *gorm.DB
+	db        *gorm.DB
+	pondQuery pond.Query
 }
 
 // ReadAllOrderActive implements Query.
 func (q *query) ReadAllOrderActive(ctx context.Context) ([]*model.Order, error) {
 	data := []*model.Order{}
-	err := q.db.Where("deleted_at IS NULL and status = ?", model.ACTIVE).Find(&data).Error
+	err := q.db.Where("deleted_at IS NULL and status IN ?", model.UnpaidStatus).Find(&data).Error
 	if err != nil {
 		return nil, errortransaction.ErrFoundOrder.AttacthDetail(map[string]any{"error": err})
 	}
 	return data, nil
 }
 
+// ReadAllOrderReservationExpired implements Query.
+func (q *query) ReadAllOrderReservationExpired(ctx context.Context) ([]*model.Order, error) {
+	data := []*model.Order{}
+	err := q.db.Where("deleted_at IS NULL and status = ? and reserved_until < ?", model.ACTIVE, time.Now()).Find(&data).Error
+	if err != nil {
+		return nil, errortransaction.ErrFoundOrder.AttacthDetail(map[string]any{"error": err})
+	}
+	return data, nil
+}
+
+// ReadOrderByBudidayaIDAndStatus implements Query.
+func (q *query) ReadOrderByBudidayaIDAndStatus(ctx context.Context, budidayaID uuid.UUID, status []string) ([]*model.Order, error) {
+	data := []*model.Order{}
+	err := q.db.Where("deleted_at IS NULL and budidaya_id = ? and status IN ?", budidayaID, status).Order("created_at").Find(&data).Error
+	if err != nil {
+		return nil, errortransaction.ErrReadOrderData.AttacthDetail(map[string]any{"error": err, "budidayaID": budidayaID})
+	}
+	return data, nil
+}
+
 // ReadOrder implements Query.
 func (q *query) ReadOrder(ctx context.Context, input model.ReadInput) (*model.OrderOutputPagination, error) {
 	var (
@@ -52,12 +95,17 @@ func (q *query) ReadOrder(ctx context.Context, input model.ReadInput) (*model.Or
 		appType, _ = ctxutil.GetUserAppType(ctx)
 	)
 
+	err := q.checkPondSeller(ctx, pondModel.TEAM_VIEW)
+	if err != nil {
+		return nil, err
+	}
+
 	input.ObjectTable = model.Order{}
 
 	db = db.Where("deleted_at is NULL")
 
 	if input.Year > 0 {
-		db = db.Where("YEAR(created_at) = ?", input.Year)
+		db = db.Where("EXTRACT(YEAR FROM created_at) = ?", input.Year)
 	}
 
 	db = db.Preload("Budidaya.Pool").Preload("Budidaya.FishSpecies")
@@ -65,7 +113,7 @@ func (q *query) ReadOrder(ctx context.Context, input model.ReadInput) (*model.Or
 	switch appType {
 	case userModel.ADMIN:
 		if input.PondID != uuid.Nil {
-			db = db.Where("pond_id = ?", pondID)
+			db = db.Where("pond_id = ?", input.PondID)
 		}
 		db = db.Preload("Budidaya.Pond")
 	case userModel.BUYER:
@@ -74,7 +122,7 @@ func (q *query) ReadOrder(ctx context.Context, input model.ReadInput) (*model.Or
 		db = db.Where("pond_id = ?", pondID).Preload("User")
 	}
 
-	err := db.Scopes(orm.Paginate(db, &input.Paginantion)).Find(&order).Error
+	err = db.Scopes(orm.Paginate(db, &input.Paginantion)).Find(&order).Error
 	if err != nil {
 		return nil, errortransaction.ErrReadOrderData.AttacthDetail(map[string]any{"error": err})
 	}
@@ -109,18 +157,23 @@ func (q *query) ReadOrderByStatus(ctx context.Context, input model.ReadInput, st
 		appType, _ = ctxutil.GetUserAppType(ctx)
 	)
 
+	err := q.checkPondSeller(ctx, pondModel.TEAM_VIEW)
+	if err != nil {
+		return nil, err
+	}
+
 	input.ObjectTable = model.Order{}
 
 	db = db.Where("deleted_at is NULL and status = ?", status)
 
 	if input.Year > 0 {
-		db = db.Where("YEAR(created_at) = ?", input.Year)
+		db = db.Where("EXTRACT(YEAR FROM created_at) = ?", input.Year)
 	}
 
 	switch appType {
 	case userModel.ADMIN:
 		if input.PondID != uuid.Nil {
-			db = db.Where("pond_id = ?", pondID)
+			db = db.Where("pond_id = ?", input.PondID)
 		}
 		db = db.Preload("Budidaya.Pond").Preload("Budidaya.Pool").Preload("Budidaya.FishSpecies")
 	case userModel.BUYER:
@@ -129,7 +182,7 @@ func (q *query) ReadOrderByStatus(ctx context.Context, input model.ReadInput, st
 		db = db.Where("pond_id = ?", pondID).Preload("Budidaya.Pool").Preload("User")
 	}
 
-	err := db.Scopes(orm.Paginate(db, &input.Paginantion)).Find(&order).Error
+	err = db.Scopes(orm.Paginate(db, &input.Paginantion)).Find(&order).Error
 	if err != nil {
 		return nil, errortransaction.ErrReadOrderData.AttacthDetail(map[string]any{"error": err})
 	}
@@ -167,3 +220,436 @@ func (q *query) ReadOrderByID(ctx context.Context, id uuid.UUID) (*model.OrderOu
 
 	return &order, nil
 }
+
+// ReadOrderDetail implements Query.
+// the order of another user or pond is returned as not found
+func (q *query) ReadOrderDetail(ctx context.Context, id uuid.UUID) (*model.OrderOutput, error) {
+	var order model.OrderOutput
+
+	err := q.checkPondSeller(ctx, pondModel.TEAM_VIEW)
+	if err != nil {
+		return nil, err
+	}
+
+	err = q.db.Scopes(scopeOrder(ctx)).
+		Preload("Budidaya.Pool").
+		Preload("Budidaya.Pond").
+		Preload("Budidaya.FishSpecies").
+		Preload("Pricelist").
+		Preload("User").
+		Where("orders.deleted_at IS NULL and orders.id = ?", id).
+		Take(&order).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errortransaction.ErrFoundOrder.AttacthDetail(map[string]any{"error": err, "id": id})
+		}
+		return nil, errortransaction.ErrReadOrderData.AttacthDetail(map[string]any{"error": err, "id": id})
+	}
+
+	return &order, nil
+}
+
+// ReadOrderInvoice implements Query.
+// the invoice of the order created by the checkout contains all orders in the same group
+func (q *query) ReadOrderInvoice(ctx context.Context, id uuid.UUID) (*model.Invoice, error) {
+	order, err := q.ReadOrderDetail(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.Status == model.CANCEL {
+		return nil, errortransaction.ErrInvoiceOrder.AttacthDetail(map[string]any{"id": id})
+	}
+
+	var (
+		code   = order.Code
+		orders = []*model.OrderOutput{order}
+	)
+
+	if order.OrderGroupID != nil {
+		group := model.OrderGroup{}
+		err = q.db.Where("deleted_at IS NULL and id = ?", order.OrderGroupID).Take(&group).Error
+		if err != nil {
+			return nil, errortransaction.ErrReadOrderData.AttacthDetail(map[string]any{"error": err, "orderGroupID": order.OrderGroupID})
+		}
+		code = group.Code
+
+		orders = []*model.OrderOutput{}
+		err = q.db.Scopes(scopeOrder(ctx)).
+			Preload("Budidaya.Pool").
+			Preload("Budidaya.FishSpecies").
+			Preload("Pricelist").
+			Preload("User").
+			Where("orders.deleted_at IS NULL and orders.order_group_id = ? and orders.status <> ?", order.OrderGroupID, model.CANCEL).
+			Order("orders.code").
+			Find(&orders).Error
+		if err != nil {
+			return nil, errortransaction.ErrReadOrderData.AttacthDetail(map[string]any{"error": err, "orderGroupID": order.OrderGroupID})
+		}
+	}
+
+	pond, err := q.pondQuery.GetPondByID(ctx, order.PondID)
+	if err != nil {
+		return nil, err
+	}
+
+	invoice := model.NewInvoice(code, orders, pond)
+	return &invoice, nil
+}
+
+// scopeOrder filter the order by the user login,
+// buyer only see his orders, seller only see the orders of his pond and admin see all orders
+func scopeOrder(ctx context.Context) func(db *gorm.DB) *gorm.DB {
+	var (
+		userID, _  = ctxutil.GetUserID(ctx)
+		pondID, _  = ctxutil.GetPondID(ctx)
+		appType, _ = ctxutil.GetUserAppType(ctx)
+	)
+
+	return func(db *gorm.DB) *gorm.DB {
+		switch appType {
+		case userModel.ADMIN:
+			return db
+		case userModel.BUYER:
+			return db.Where("orders.user_id = ?", userID)
+		case userModel.SELLER:
+			return db.Where("orders.pond_id = ?", pondID)
+		default:
+			return db.Where("1 = 0")
+		}
+	}
+}
+
+// ReadOrderStatusHistory implements Query.
+func (q *query) ReadOrderStatusHistory(ctx context.Context, orderID uuid.UUID) ([]*model.OrderStatusHistoryOutput, error) {
+	var (
+		order   model.OrderOutput
+		history []*model.OrderStatusHistoryOutput
+	)
+
+	err := q.checkPondSeller(ctx, pondModel.TEAM_VIEW)
+	if err != nil {
+		return nil, err
+	}
+
+	err = q.db.Scopes(scopeOrder(ctx)).Where("orders.deleted_at IS NULL and orders.id = ?", orderID).Take(&order).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errortransaction.ErrFoundOrder.AttacthDetail(map[string]any{"error": err, "id": orderID})
+		}
+		return nil, errortransaction.ErrReadOrderData.AttacthDetail(map[string]any{"error": err, "id": orderID})
+	}
+
+	err = q.db.Where("deleted_at IS NULL and order_id = ?", orderID).Preload("User").Order("created_at ASC").Find(&history).Error
+	if err != nil {
+		return nil, errortransaction.ErrReadOrderData.AttacthDetail(map[string]any{"error": err, "id": orderID})
+	}
+
+	return history, nil
+}
+
+// ReadPaymentByExternalID implements Query.
+func (q *query) ReadPaymentByExternalID(ctx context.Context, externalID string) (*model.Payment, error) {
+	var payment model.Payment
+
+	err := q.db.Where("deleted_at IS NULL and external_id = ?", externalID).Take(&payment).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errortransaction.ErrFoundPayment.AttacthDetail(map[string]any{"error": err, "externalID": externalID})
+		}
+		return nil, errortransaction.ErrReadOrderData.AttacthDetail(map[string]any{"error": err, "externalID": externalID})
+	}
+
+	return &payment, nil
+}
+
+// ReadPaymentByOrderIDAndStatus implements Query.
+func (q *query) ReadPaymentByOrderIDAndStatus(ctx context.Context, orderID uuid.UUID, status string) (*model.Payment, error) {
+	var payment model.Payment
+
+	err := q.db.Where("deleted_at IS NULL and order_id = ? and status = ?", orderID, status).Order("created_at DESC").First(&payment).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errortransaction.ErrFoundPayment.AttacthDetail(map[string]any{"error": err, "orderID": orderID})
+		}
+		return nil, errortransaction.ErrReadOrderData.AttacthDetail(map[string]any{"error": err, "orderID": orderID})
+	}
+
+	return &payment, nil
+}
+
+// ReadPaymentByID implements Query.
+func (q *query) ReadPaymentByID(ctx context.Context, id uuid.UUID) (*model.Payment, error) {
+	var payment model.Payment
+
+	err := q.db.Where("deleted_at IS NULL and id = ?", id).Take(&payment).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errortransaction.ErrFoundPayment.AttacthDetail(map[string]any{"error": err, "id": id})
+		}
+		return nil, errortransaction.ErrReadOrderData.AttacthDetail(map[string]any{"error": err, "id": id})
+	}
+
+	return &payment, nil
+}
+
+// ReadAllPaymentByStatus implements Query.
+func (q *query) ReadAllPaymentByStatus(ctx context.Context, status string) ([]*model.Payment, error) {
+	var payments = []*model.Payment{}
+
+	err := q.db.Where("deleted_at IS NULL and status = ?", status).Order("updated_at ASC").Find(&payments).Error
+	if err != nil {
+		return nil, errortransaction.ErrReadOrderData.AttacthDetail(map[string]any{"error": err, "status": status})
+	}
+
+	return payments, nil
+}
+
+// ReadCart implements Query.
+func (q *query) ReadCart(ctx context.Context) ([]*model.CartOutput, error) {
+	var (
+		userID, _ = ctxutil.GetUserID(ctx)
+		carts     = []*model.CartOutput{}
+	)
+
+	err := q.db.Where("deleted_at IS NULL and user_id = ?", userID).
+		Preload("Pond").
+		Preload("Items", "deleted_at IS NULL").
+		Preload("Items.Budidaya.FishSpecies").
+		Preload("Items.Budidaya.PriceList", budidaya.EffectivePricelist(time.Now())).
+		Order("created_at DESC").
+		Find(&carts).Error
+	if err != nil {
+		return nil, errortransaction.ErrReadCartData.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return carts, nil
+}
+
+// ReadUserByID implements Query.
+func (q *query) ReadUserByID(ctx context.Context, id uuid.UUID) (*model.User, error) {
+	var user model.User
+
+	err := q.db.Where("deleted_at IS NULL and id = ?", id).Take(&user).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errortransaction.ErrFoundUser.AttacthDetail(map[string]any{"error": err, "id": id})
+		}
+		return nil, errortransaction.ErrReadOrderData.AttacthDetail(map[string]any{"error": err, "id": id})
+	}
+
+	return &user, nil
+}
+
+// ReadCartByID implements Query.
+func (q *query) ReadCartByID(ctx context.Context, id uuid.UUID) (*model.Cart, error) {
+	var (
+		userID, _ = ctxutil.GetUserID(ctx)
+		cart      model.Cart
+	)
+
+	err := q.db.Where("deleted_at IS NULL and id = ? and user_id = ?", id, userID).Preload("Items", "deleted_at IS NULL").Take(&cart).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errortransaction.ErrFoundCart.AttacthDetail(map[string]any{"error": err, "id": id})
+		}
+		return nil, errortransaction.ErrReadCartData.AttacthDetail(map[string]any{"error": err, "id": id})
+	}
+
+	return &cart, nil
+}
+
+// ReadCartByPondID implements Query.
+func (q *query) ReadCartByPondID(ctx context.Context, pondID uuid.UUID) (*model.Cart, error) {
+	var (
+		userID, _ = ctxutil.GetUserID(ctx)
+		cart      model.Cart
+	)
+
+	err := q.db.Where("deleted_at IS NULL and pond_id = ? and user_id = ?", pondID, userID).Preload("Items", "deleted_at IS NULL").Take(&cart).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errortransaction.ErrFoundCart.AttacthDetail(map[string]any{"error": err, "pondID": pondID})
+		}
+		return nil, errortransaction.ErrReadCartData.AttacthDetail(map[string]any{"error": err, "pondID": pondID})
+	}
+
+	return &cart, nil
+}
+
+// ReadCartItemByID implements Query.
+func (q *query) ReadCartItemByID(ctx context.Context, id uuid.UUID) (*model.CartItem, error) {
+	var (
+		userID, _ = ctxutil.GetUserID(ctx)
+		item      model.CartItem
+	)
+
+	err := q.db.Select("cart_items.*").
+		Joins("JOIN carts ON carts.id = cart_items.cart_id").
+		Where("cart_items.deleted_at IS NULL and cart_items.id = ? and carts.user_id = ?", id, userID).
+		Take(&item).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errortransaction.ErrFoundCart.AttacthDetail(map[string]any{"error": err, "id": id})
+		}
+		return nil, errortransaction.ErrReadCartData.AttacthDetail(map[string]any{"error": err, "id": id})
+	}
+
+	return &item, nil
+}
+
+// scopeSales filter the order in the date range of the sales analytics,
+// the end date is inclusive
+func scopeSales(ctx context.Context, input model.SalesInput) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		db = db.Scopes(scopeOrder(ctx)).
+			Where("orders.deleted_at IS NULL and orders.created_at >= ? and orders.created_at < ?", input.StartDate, input.EndDate.AddDate(0, 0, 1))
+		if input.PondID != uuid.Nil {
+			db = db.Where("orders.pond_id = ?", input.PondID)
+		}
+		return db
+	}
+}
+
+// ReadSalesRevenue implements Query.
+// EXTRACT is used instead of YEAR() or DATE_TRUNC() so the query works on postgres and mysql
+func (q *query) ReadSalesRevenue(ctx context.Context, input model.SalesInput) ([]*model.SalesRevenueOutput, error) {
+	var (
+		data    = []*model.SalesRevenueOutput{}
+		periods = []string{"EXTRACT(YEAR FROM orders.created_at)"}
+		selects = []string{"EXTRACT(YEAR FROM orders.created_at) AS year"}
+	)
+
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	err = q.checkPondSeller(ctx, pondModel.TEAM_VIEW)
+	if err != nil {
+		return nil, err
+	}
+
+	switch input.Granularity {
+	case model.MONTHLY:
+		periods = append(periods, "EXTRACT(MONTH FROM orders.created_at)")
+		selects = append(selects, "EXTRACT(MONTH FROM orders.created_at) AS month")
+	case model.DAILY:
+		periods = append(periods, "EXTRACT(MONTH FROM orders.created_at)", "EXTRACT(DAY FROM orders.created_at)")
+		selects = append(selects, "EXTRACT(MONTH FROM orders.created_at) AS month", "EXTRACT(DAY FROM orders.created_at) AS day")
+	}
+
+	selects = append(selects, "COUNT(orders.id) AS total_order", "SUM(orders.qty) AS qty", "SUM(orders.ammout) AS revenue")
+
+	err = q.db.Table("orders").
+		Scopes(scopeSales(ctx, input)).
+		Select(strings.Join(selects, ", ")).
+		Where("orders.status IN ?", model.SalesStatus).
+		Group(strings.Join(periods, ", ")).
+		Order(strings.Join(periods, ", ")).
+		Scan(&data).Error
+	if err != nil {
+		return nil, errortransaction.ErrReadSalesData.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return data, nil
+}
+
+// ReadSalesFishSpecies implements Query.
+func (q *query) ReadSalesFishSpecies(ctx context.Context, input model.SalesInput) ([]*model.SalesFishSpeciesOutput, error) {
+	data := []*model.SalesFishSpeciesOutput{}
+
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	err = q.checkPondSeller(ctx, pondModel.TEAM_VIEW)
+	if err != nil {
+		return nil, err
+	}
+
+	err = q.db.Table("orders").
+		Scopes(scopeSales(ctx, input)).
+		Select("fish_species.id AS fish_species_id, fish_species.name AS fish_species_name, COUNT(orders.id) AS total_order, SUM(orders.qty) AS qty, SUM(orders.ammout) AS revenue").
+		Joins("JOIN budidayas ON budidayas.id = orders.budidaya_id").
+		Joins("JOIN fish_species ON fish_species.id = budidayas.fish_species_id").
+		Where("orders.status IN ?", model.SalesStatus).
+		Group("fish_species.id, fish_species.name").
+		Order("qty DESC").
+		Scan(&data).Error
+	if err != nil {
+		return nil, errortransaction.ErrReadSalesData.AttacthDetail(map[string]any{"error": err})
+	}
+
+	for _, v := range data {
+		if v.Qty > 0 {
+			v.AveragePrice = v.Revenue / float64(v.Qty)
+		}
+	}
+
+	return data, nil
+}
+
+// ReadSalesTopBuyer implements Query.
+func (q *query) ReadSalesTopBuyer(ctx context.Context, input model.SalesInput) ([]*model.SalesTopBuyerOutput, error) {
+	data := []*model.SalesTopBuyerOutput{}
+
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	err = q.checkPondSeller(ctx, pondModel.TEAM_VIEW)
+	if err != nil {
+		return nil, err
+	}
+
+	err = q.db.Table("orders").
+		Scopes(scopeSales(ctx, input)).
+		Select("users.id AS user_id, users.name AS name, COUNT(orders.id) AS total_order, SUM(orders.qty) AS qty, SUM(orders.ammout) AS revenue").
+		Joins("JOIN users ON users.id = orders.user_id").
+		Where("orders.status IN ?", model.SalesStatus).
+		Group("users.id, users.name").
+		Order("revenue DESC").
+		Limit(input.Limit).
+		Scan(&data).Error
+	if err != nil {
+		return nil, errortransaction.ErrReadSalesData.AttacthDetail(map[string]any{"error": err})
+	}
+
+	return data, nil
+}
+
+// ReadSalesSummary implements Query.
+// the order is counted once by status, so the cancellation rate is canceled order / all order in the date range
+func (q *query) ReadSalesSummary(ctx context.Context, input model.SalesInput) (*model.SalesSummaryOutput, error) {
+	data := model.SalesSummaryOutput{}
+
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	err = q.checkPondSeller(ctx, pondModel.TEAM_VIEW)
+	if err != nil {
+		return nil, err
+	}
+
+	err = q.db.Table("orders").
+		Scopes(scopeSales(ctx, input)).
+		Select(`COUNT(orders.id) AS total_order,
+			COALESCE(SUM(CASE WHEN orders.status IN ? THEN 1 ELSE 0 END), 0) AS total_sales,
+			COALESCE(SUM(CASE WHEN orders.status = ? THEN 1 ELSE 0 END), 0) AS total_cancel,
+			COALESCE(SUM(CASE WHEN orders.status IN ? THEN orders.qty ELSE 0 END), 0) AS qty,
+			COALESCE(SUM(CASE WHEN orders.status IN ? THEN orders.ammout ELSE 0 END), 0) AS revenue`,
+			model.SalesStatus, model.CANCEL, model.SalesStatus, model.SalesStatus).
+		Scan(&data).Error
+	if err != nil {
+		return nil, errortransaction.ErrReadSalesData.AttacthDetail(map[string]any{"error": err})
+	}
+
+	data.Calculate()
+
+	return &data, nil
+}
@@ -5,35 +5,41 @@ import (
 
 	"github.com/e-fish/api/pkg/common/helper/config"
 	"github.com/e-fish/api/pkg/common/infra/orm"
+	"github.com/e-fish/api/pkg/common/infra/payment"
 	"github.com/e-fish/api/pkg/domain/budidaya"
+	"github.com/e-fish/api/pkg/domain/pond"
 	"gorm.io/gorm"
 )
 
-func NewRepo(dbConfig config.DbConfig, budidayaRepo budidaya.Repo) (Repo, error) {
+func NewRepo(dbConfig config.DbConfig, budidayaRepo budidaya.Repo, pondRepo pond.Repo, paymentGateway payment.PaymentGateway) (Repo, error) {
 	db, err := orm.CreateConnetionDB(dbConfig)
 	if err != nil {
 		return nil, err
 	}
 
 	return &ProductRepo{
-		DbConfig:     dbConfig,
-		db:           db,
-		budidayaRepo: budidayaRepo,
+		DbConfig:       dbConfig,
+		db:             db,
+		budidayaRepo:   budidayaRepo,
+		pondRepo:       pondRepo,
+		paymentGateway: paymentGateway,
 	}, err
 }
 
 type ProductRepo struct {
-	DbConfig     config.DbConfig
-	db           *gorm.DB
-	budidayaRepo budidaya.Repo
+	DbConfig       config.DbConfig
+	db             *gorm.DB
+	budidayaRepo   budidaya.Repo
+	pondRepo       pond.Repo
+	paymentGateway payment.PaymentGateway
 }
 
 // NewCommand implements Repo.
 func (a *ProductRepo) NewCommand(ctx context.Context) Command {
-	return newCommand(ctx, a.db, a.budidayaRepo)
+	return newCommand(ctx, a.db, a.budidayaRepo, a.pondRepo, a.paymentGateway)
 }
 
 // NewQuery implements Repo.
 func (a *ProductRepo) NewQuery() Query {
-	return newQuery(a.db)
+	return newQuery(a.db, a.pondRepo)
 }
@@ -19,6 +19,7 @@ type PondConfig struct {
 	PondImageConfig config.ImageConfig
 	PoolImageConfig config.ImageConfig
 	DbConfig        config.DbConfig
+	FireBaseConfig  config.FirebaseConfig
 }
 
 // single tone
@@ -43,6 +44,7 @@ func getConfig() *PondConfig {
 			pondImageUrl := os.Getenv("URL_IMAGE_POND")
 			poolImagePath := os.Getenv("PATH_IMAGE_POOL")
 			poolImageUrl := os.Getenv("URL_IMAGE_POOL")
+			firebaseConf := os.Getenv("FIREBASE_CONF")
 
 			conf = &PondConfig{
 				PondImageConfig: config.ImageConfig{
@@ -54,6 +56,9 @@ func getConfig() *PondConfig {
 					Path: poolImagePath,
 				},
 				DbConfig: config.DbConfig{Driver: driver, Host: host, User: username, Password: password, Database: database, Port: port},
+				FireBaseConfig: config.FirebaseConfig{
+					FireBase: firebaseConf,
+				},
 			}
 		})
 	}
@@ -1,6 +1,7 @@
 package pondhandler
 
 import (
+	"github.com/e-fish/api/pkg/common/helper/geo"
 	"github.com/e-fish/api/pkg/common/helper/restsvr"
 	"github.com/e-fish/api/pkg/common/helper/werror"
 	"github.com/e-fish/api/pkg/domain/pond/model"
@@ -123,7 +124,13 @@ func (h *Handler) GetAllPond(c *gin.Context) {
 
 	defer restsvr.ResponsJson(c, res)
 
-	result, err := h.Service.GetListPond(ctx)
+	nearby, err := geo.ParseNearby(c.Query("lat"), c.Query("lng"), c.Query("radiusKm"))
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.GetListPond(ctx, nearby)
 	res.Add(result, err)
 }
 
@@ -193,3 +200,265 @@ func (h *Handler) SaveImagePool(c *gin.Context) {
 	result, err := h.Service.SaveImagesPool(ctx, file)
 	res.Add(result, err)
 }
+
+func (h *Handler) AssignReviewerPond(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req model.AssignReviewerInput
+		res = new(restsvr.HttpResponse)
+	)
+
+	defer restsvr.ResponsJson(c, res)
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.AssignReviewerPond(ctx, req)
+	res.Add(result, err)
+}
+
+func (h *Handler) ReviewBerkasPond(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req model.ReviewBerkasInput
+		res = new(restsvr.HttpResponse)
+	)
+
+	defer restsvr.ResponsJson(c, res)
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.ReviewBerkasPond(ctx, req)
+	res.Add(result, err)
+}
+
+func (h *Handler) GetListReviewNote(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		res = new(restsvr.HttpResponse)
+	)
+
+	defer restsvr.ResponsJson(c, res)
+
+	uid, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.GetListReviewNote(ctx, uid)
+	res.Add(result, err)
+}
+
+func (h *Handler) CreatePool(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req model.CreatePoolInput
+		res = new(restsvr.HttpResponse)
+	)
+
+	defer restsvr.ResponsJson(c, res)
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.CreatePool(ctx, req)
+	res.Add(result, err)
+}
+
+func (h *Handler) UpdatePool(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req model.UpdatePoolInput
+		res = new(restsvr.HttpResponse)
+	)
+
+	defer restsvr.ResponsJson(c, res)
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.UpdatePool(ctx, req)
+	res.Add(result, err)
+}
+
+func (h *Handler) ArchivePool(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req model.ArchivePoolInput
+		res = new(restsvr.HttpResponse)
+	)
+
+	defer restsvr.ResponsJson(c, res)
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.ArchivePool(ctx, req)
+	res.Add(result, err)
+}
+
+func (h *Handler) CreateTeam(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req model.CreateTeamInput
+		res = new(restsvr.HttpResponse)
+	)
+
+	defer restsvr.ResponsJson(c, res)
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.CreateTeam(ctx, req)
+	res.Add(result, err)
+}
+
+func (h *Handler) InviteTeamMember(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req model.InviteTeamMemberInput
+		res = new(restsvr.HttpResponse)
+	)
+
+	defer restsvr.ResponsJson(c, res)
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.InviteTeamMember(ctx, req)
+	res.Add(result, err)
+}
+
+func (h *Handler) AcceptTeamInvitation(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req model.AcceptTeamInvitationInput
+		res = new(restsvr.HttpResponse)
+	)
+
+	defer restsvr.ResponsJson(c, res)
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.AcceptTeamInvitation(ctx, req)
+	res.Add(result, err)
+}
+
+func (h *Handler) RemoveTeamMember(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req model.RemoveTeamMemberInput
+		res = new(restsvr.HttpResponse)
+	)
+
+	defer restsvr.ResponsJson(c, res)
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.RemoveTeamMember(ctx, req)
+	res.Add(result, err)
+}
+
+func (h *Handler) GetListTeam(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		res = new(restsvr.HttpResponse)
+	)
+
+	defer restsvr.ResponsJson(c, res)
+
+	result, err := h.Service.GetListTeam(ctx)
+	res.Add(result, err)
+}
+
+func (h *Handler) GetTeamByID(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		res = new(restsvr.HttpResponse)
+	)
+
+	defer restsvr.ResponsJson(c, res)
+
+	uid, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.GetTeamByID(ctx, uid)
+	res.Add(result, err)
+}
+
+func (h *Handler) GetListTeamInvitation(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		res = new(restsvr.HttpResponse)
+	)
+
+	defer restsvr.ResponsJson(c, res)
+
+	result, err := h.Service.GetListTeamInvitation(ctx)
+	res.Add(result, err)
+}
+
+func (h *Handler) GetListMyPond(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		res = new(restsvr.HttpResponse)
+	)
+
+	defer restsvr.ResponsJson(c, res)
+
+	result, err := h.Service.GetListMyPond(ctx)
+	res.Add(result, err)
+}
+
+func (h *Handler) SelectPond(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req model.SelectPondInput
+		res = new(restsvr.HttpResponse)
+	)
+
+	defer restsvr.ResponsJson(c, res)
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.SelectPond(ctx, req)
+	res.Add(result, err)
+}
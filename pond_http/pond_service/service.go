@@ -7,10 +7,19 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/e-fish/api/pkg/common/helper/geo"
 	"github.com/e-fish/api/pkg/common/helper/logger"
 	"github.com/e-fish/api/pkg/common/helper/savefile"
 	"github.com/e-fish/api/pkg/common/helper/werror"
+	"github.com/e-fish/api/pkg/common/infra/firebase"
+	"github.com/e-fish/api/pkg/common/infra/token"
+	"github.com/e-fish/api/pkg/domain/auth"
+	authmodel "github.com/e-fish/api/pkg/domain/auth/model"
+	"github.com/e-fish/api/pkg/domain/budidaya"
+	errorbudidaya "github.com/e-fish/api/pkg/domain/budidaya/error-budidaya"
+	"github.com/e-fish/api/pkg/domain/notification"
 	"github.com/e-fish/api/pkg/domain/pond"
+	errorpond "github.com/e-fish/api/pkg/domain/pond/error-pond"
 	"github.com/e-fish/api/pkg/domain/pond/model"
 	"github.com/e-fish/api/pkg/domain/verification"
 	pondconfig "github.com/e-fish/api/pond_http/pond_config"
@@ -18,28 +27,65 @@ import (
 )
 
 func NewService(conf pondconfig.PondConfig) Service {
+	var (
+		ctx = context.Background()
+	)
 
 	verificationRepo, err := verification.NewRepo(conf.DbConfig)
 	if err != nil {
 		logger.Fatal("###failed create pond service err: %v", err)
 	}
 
-	pondRepo, err := pond.NewRepo(conf.DbConfig, verificationRepo)
+	fb, err := firebase.NewFirebase(conf.FireBaseConfig)
+	if err != nil {
+		logger.Fatal("###failed create firebase service err: %v", err)
+	}
+
+	messaging, err := fb.NewMessaging(ctx)
+	if err != nil {
+		logger.Fatal("###failed create firebase messaging err: %v", err)
+	}
+
+	notificationRepo, err := notification.NewRepo(conf.DbConfig, messaging)
+	if err != nil {
+		logger.Fatal("###failed create pond service err: %v", err)
+	}
+
+	pondRepo, err := pond.NewRepo(conf.DbConfig, verificationRepo, notificationRepo)
+	if err != nil {
+		logger.Fatal("###failed create pond service err: %v", err)
+	}
+
+	budidayaRepo, err := budidaya.NewRepo(conf.DbConfig, pondRepo)
+	if err != nil {
+		logger.Fatal("###failed create pond service err: %v", err)
+	}
+
+	tokenMaker, err := token.NewTokenMaker(token.SecretKey)
+	if err != nil {
+		logger.Fatal("###failed create token maker service err: %v", err)
+	}
+
+	authRepo, err := auth.NewRepo(conf.DbConfig, tokenMaker, fb)
 	if err != nil {
 		logger.Fatal("###failed create pond service err: %v", err)
 	}
 
 	service := Service{
-		conf: conf,
-		repo: pondRepo,
+		conf:         conf,
+		repo:         pondRepo,
+		budidayaRepo: budidayaRepo,
+		authRepo:     authRepo,
 	}
 
 	return service
 }
 
 type Service struct {
-	conf pondconfig.PondConfig
-	repo pond.Repo
+	conf         pondconfig.PondConfig
+	repo         pond.Repo
+	budidayaRepo budidaya.Repo
+	authRepo     auth.Repo
 }
 
 func (s *Service) CreatePond(ctx context.Context, input model.CreatePondInput) (*uuid.UUID, error) {
@@ -82,18 +128,46 @@ func (s *Service) UpdatePond(ctx context.Context, input model.UpdatePondInput) (
 	return result, nil
 }
 
+// ResubmissionPond the deleted pool of the resubmission is archived, so it is checked the same as the archive pool
 func (s *Service) ResubmissionPond(ctx context.Context, input model.Resubmission) (*uuid.UUID, error) {
-	command := s.repo.NewCommand(ctx)
+	var (
+		command         = s.repo.NewCommand(ctx)
+		budidayaCommand = s.budidayaRepo.NewCommand(ctx)
+		rollback        = func() {
+			if err := budidayaCommand.Rollback(ctx); err != nil {
+				logger.ErrorWithContext(ctx, "failed rollback transaction budidaya resubmission pond err: %v", err)
+			}
+			if err := command.Rollback(ctx); err != nil {
+				logger.ErrorWithContext(ctx, "failed rollback transaction resubmission pond err: %v", err)
+			}
+		}
+	)
 
 	result, err := command.ResubmissionPond(ctx, input)
 	if err != nil {
-		if err := command.Rollback(ctx); err != nil {
-			logger.ErrorWithContext(ctx, "failed rollback transaction resubmission pond err: %v", err)
-		}
+		rollback()
 		logger.ErrorWithContext(ctx, "failed resubmission pond err: %v", err)
 		return nil, err
 	}
 
+	for _, v := range input.ListPool {
+		if !v.IsDeleted || v.ID == uuid.Nil {
+			continue
+		}
+
+		err = s.checkPoolBudidaya(ctx, budidayaCommand, v.ID)
+		if err != nil {
+			rollback()
+			logger.ErrorWithContext(ctx, "failed resubmission pond err: %v", err)
+			return nil, err
+		}
+	}
+
+	if err := budidayaCommand.Commit(ctx); err != nil {
+		logger.ErrorWithContext(ctx, "failed commit transaction budidaya resubmission pond err: %v", err)
+		return nil, err
+	}
+
 	if err := command.Commit(ctx); err != nil {
 		logger.ErrorWithContext(ctx, "failed commit transaction resubmission pond err: %v", err)
 		return nil, err
@@ -132,9 +206,9 @@ func (s *Service) GetAllPondSubmission(ctx context.Context) ([]*model.PondOutput
 	return query.GetListPondSubmission(ctx)
 }
 
-func (s *Service) GetListPond(ctx context.Context) ([]*model.PondOutput, error) {
+func (s *Service) GetListPond(ctx context.Context, nearby geo.Nearby) ([]*model.PondOutput, error) {
 	query := s.repo.NewQuery()
-	return query.GetListPond(ctx)
+	return query.GetListPond(ctx, nearby)
 }
 
 func (s *Service) GetListPool(ctx context.Context, id uuid.UUID) ([]*model.PoolOutput, error) {
@@ -230,3 +304,281 @@ func (s *Service) SaveImagesPool(ctx context.Context, file *multipart.FileHeader
 
 	return &result, nil
 }
+
+func (s *Service) AssignReviewerPond(ctx context.Context, input model.AssignReviewerInput) (*uuid.UUID, error) {
+	command := s.repo.NewCommand(ctx)
+
+	result, err := command.AssignReviewerPond(ctx, input)
+	if err != nil {
+		if err := command.Rollback(ctx); err != nil {
+			logger.ErrorWithContext(ctx, "failed rollback transaction assign reviewer pond err: %v", err)
+		}
+		logger.ErrorWithContext(ctx, "failed assign reviewer pond err: %v", err)
+		return nil, err
+	}
+
+	if err := command.Commit(ctx); err != nil {
+		logger.ErrorWithContext(ctx, "failed commit transaction assign reviewer pond err: %v", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *Service) ReviewBerkasPond(ctx context.Context, input model.ReviewBerkasInput) (*model.PondOutput, error) {
+	command := s.repo.NewCommand(ctx)
+
+	result, err := command.ReviewBerkasPond(ctx, input)
+	if err != nil {
+		if err := command.Rollback(ctx); err != nil {
+			logger.ErrorWithContext(ctx, "failed rollback transaction review berkas pond err: %v", err)
+		}
+		logger.ErrorWithContext(ctx, "failed review berkas pond err: %v", err)
+		return nil, err
+	}
+
+	if err := command.Commit(ctx); err != nil {
+		logger.ErrorWithContext(ctx, "failed commit transaction review berkas pond err: %v", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *Service) GetListReviewNote(ctx context.Context, pondID uuid.UUID) ([]*model.ReviewNoteOutput, error) {
+	query := s.repo.NewQuery()
+	return query.GetListReviewNoteByPondID(ctx, pondID)
+}
+
+func (s *Service) CreatePool(ctx context.Context, input model.CreatePoolInput) (*uuid.UUID, error) {
+	command := s.repo.NewCommand(ctx)
+
+	result, err := command.CreatePool(ctx, input)
+	if err != nil {
+		if err := command.Rollback(ctx); err != nil {
+			logger.ErrorWithContext(ctx, "failed rollback transaction create pool err: %v", err)
+		}
+		logger.ErrorWithContext(ctx, "failed create pool err: %v", err)
+		return nil, err
+	}
+
+	if err := command.Commit(ctx); err != nil {
+		logger.ErrorWithContext(ctx, "failed commit transaction create pool err: %v", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *Service) UpdatePool(ctx context.Context, input model.UpdatePoolInput) (*uuid.UUID, error) {
+	command := s.repo.NewCommand(ctx)
+
+	result, err := command.UpdatePool(ctx, input)
+	if err != nil {
+		if err := command.Rollback(ctx); err != nil {
+			logger.ErrorWithContext(ctx, "failed rollback transaction update pool err: %v", err)
+		}
+		logger.ErrorWithContext(ctx, "failed update pool err: %v", err)
+		return nil, err
+	}
+
+	if err := command.Commit(ctx); err != nil {
+		logger.ErrorWithContext(ctx, "failed commit transaction update pool err: %v", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ArchivePool archive the pool that doesn't have the active budidaya
+func (s *Service) ArchivePool(ctx context.Context, input model.ArchivePoolInput) (*uuid.UUID, error) {
+	var (
+		command         = s.repo.NewCommand(ctx)
+		budidayaCommand = s.budidayaRepo.NewCommand(ctx)
+	)
+
+	result, err := s.archivePool(ctx, command, budidayaCommand, input)
+	if err != nil {
+		if err := budidayaCommand.Rollback(ctx); err != nil {
+			logger.ErrorWithContext(ctx, "failed rollback transaction budidaya archive pool err: %v", err)
+		}
+		if err := command.Rollback(ctx); err != nil {
+			logger.ErrorWithContext(ctx, "failed rollback transaction archive pool err: %v", err)
+		}
+		logger.ErrorWithContext(ctx, "failed archive pool err: %v", err)
+		return nil, err
+	}
+
+	if err := budidayaCommand.Commit(ctx); err != nil {
+		logger.ErrorWithContext(ctx, "failed commit transaction budidaya archive pool err: %v", err)
+		return nil, err
+	}
+
+	if err := command.Commit(ctx); err != nil {
+		logger.ErrorWithContext(ctx, "failed commit transaction archive pool err: %v", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *Service) archivePool(ctx context.Context, command pond.Command, budidayaCommand budidaya.Command, input model.ArchivePoolInput) (*uuid.UUID, error) {
+	result, err := command.ArchivePool(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkPoolBudidaya(ctx, budidayaCommand, input.PoolID)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// checkPoolBudidaya the archive is rolled back when the pool still has the active budidaya,
+// the pool is read in the same transaction with the lock, so the budidaya can't be created in the pool before it is committed
+func (s *Service) checkPoolBudidaya(ctx context.Context, command budidaya.Command, poolID uuid.UUID) error {
+	exist, err := command.ReadBudidayaActiveByLockedPool(ctx, poolID)
+	if err == nil {
+		return errorpond.ErrPoolHasActiveBudidaya.AttacthDetail(map[string]any{"budidayaID": exist.ID})
+	}
+	if !errorbudidaya.ErrFoundBudidaya.Is(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) CreateTeam(ctx context.Context, input model.CreateTeamInput) (*uuid.UUID, error) {
+	command := s.repo.NewCommand(ctx)
+
+	result, err := command.CreateTeam(ctx, input)
+	if err != nil {
+		if err := command.Rollback(ctx); err != nil {
+			logger.ErrorWithContext(ctx, "failed rollback transaction create team err: %v", err)
+		}
+		logger.ErrorWithContext(ctx, "failed create team err: %v", err)
+		return nil, err
+	}
+
+	if err := command.Commit(ctx); err != nil {
+		logger.ErrorWithContext(ctx, "failed commit transaction create team err: %v", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *Service) InviteTeamMember(ctx context.Context, input model.InviteTeamMemberInput) (*uuid.UUID, error) {
+	command := s.repo.NewCommand(ctx)
+
+	result, err := command.InviteTeamMember(ctx, input)
+	if err != nil {
+		if err := command.Rollback(ctx); err != nil {
+			logger.ErrorWithContext(ctx, "failed rollback transaction invite team member err: %v", err)
+		}
+		logger.ErrorWithContext(ctx, "failed invite team member err: %v", err)
+		return nil, err
+	}
+
+	if err := command.Commit(ctx); err != nil {
+		logger.ErrorWithContext(ctx, "failed commit transaction invite team member err: %v", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *Service) AcceptTeamInvitation(ctx context.Context, input model.AcceptTeamInvitationInput) (*uuid.UUID, error) {
+	command := s.repo.NewCommand(ctx)
+
+	result, err := command.AcceptTeamInvitation(ctx, input)
+	if err != nil {
+		if err := command.Rollback(ctx); err != nil {
+			logger.ErrorWithContext(ctx, "failed rollback transaction accept team invitation err: %v", err)
+		}
+		logger.ErrorWithContext(ctx, "failed accept team invitation err: %v", err)
+		return nil, err
+	}
+
+	if err := command.Commit(ctx); err != nil {
+		logger.ErrorWithContext(ctx, "failed commit transaction accept team invitation err: %v", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *Service) RemoveTeamMember(ctx context.Context, input model.RemoveTeamMemberInput) (*uuid.UUID, error) {
+	command := s.repo.NewCommand(ctx)
+
+	result, err := command.RemoveTeamMember(ctx, input)
+	if err != nil {
+		if err := command.Rollback(ctx); err != nil {
+			logger.ErrorWithContext(ctx, "failed rollback transaction remove team member err: %v", err)
+		}
+		logger.ErrorWithContext(ctx, "failed remove team member err: %v", err)
+		return nil, err
+	}
+
+	if err := command.Commit(ctx); err != nil {
+		logger.ErrorWithContext(ctx, "failed commit transaction remove team member err: %v", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *Service) GetListTeam(ctx context.Context) ([]*model.TeamOutput, error) {
+	query := s.repo.NewQuery()
+	return query.GetListTeam(ctx)
+}
+
+func (s *Service) GetTeamByID(ctx context.Context, id uuid.UUID) (*model.TeamOutput, error) {
+	query := s.repo.NewQuery()
+	return query.GetTeamByID(ctx, id)
+}
+
+func (s *Service) GetListTeamInvitation(ctx context.Context) ([]*model.TeamMemberOutput, error) {
+	query := s.repo.NewQuery()
+	return query.GetListTeamInvitation(ctx)
+}
+
+func (s *Service) GetListMyPond(ctx context.Context) ([]*model.PondOutput, error) {
+	query := s.repo.NewQuery()
+	return query.GetListMyPond(ctx)
+}
+
+// SelectPond switch the pond of the user, the new token carry the selected pond
+func (s *Service) SelectPond(ctx context.Context, input model.SelectPondInput) (*authmodel.UserLoginOutput, error) {
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	query := s.repo.NewQuery()
+
+	_, err = query.CheckPondPermission(ctx, input.PondID, model.TEAM_VIEW)
+	if err != nil {
+		return nil, err
+	}
+
+	command := s.authRepo.NewCommand(ctx)
+
+	result, err := command.SelectPond(ctx, input.PondID)
+	if err != nil {
+		if err := command.Rollback(ctx); err != nil {
+			logger.ErrorWithContext(ctx, "failed rollback transaction select pond err: %v", err)
+		}
+		logger.ErrorWithContext(ctx, "failed select pond err: %v", err)
+		return nil, err
+	}
+
+	if err := command.Commit(ctx); err != nil {
+		logger.ErrorWithContext(ctx, "failed commit transaction select pond err: %v", err)
+		return nil, err
+	}
+
+	return result, nil
+}
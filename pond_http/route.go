@@ -2,6 +2,8 @@ package pondhttp
 
 import (
 	"github.com/e-fish/api/pkg/common/helper/ctxutil"
+	"github.com/e-fish/api/pkg/common/helper/logger"
+	"github.com/e-fish/api/pkg/common/helper/restsvr"
 	pondconfig "github.com/e-fish/api/pond_http/pond_config"
 	pondhandler "github.com/e-fish/api/pond_http/pond_handler"
 	pondservice "github.com/e-fish/api/pond_http/pond_service"
@@ -23,16 +25,38 @@ func newRoute(ro route) {
 		Service: service,
 	}
 
-	ginEngine.POST("/create-pond", ctxutil.Authorization(), handler.CreatePond)
+	idempotencyStore, err := restsvr.NewIdempotencyStore(ro.conf.DbConfig)
+	if err != nil {
+		logger.Fatal("###failed create idempotency store err: %v", err)
+	}
+	idempotency := restsvr.Idempotency(idempotencyStore)
+
+	ginEngine.POST("/create-pond", ctxutil.Authorization(), idempotency, handler.CreatePond)
 	ginEngine.POST("/update-pond", ctxutil.Authorization(), handler.UpdatePond)
 	ginEngine.POST("/resubmission-pond", ctxutil.Authorization(), handler.ResubmissionPond)
 	ginEngine.GET("/pond", ctxutil.Authorization(), handler.GetPondByUserAdmin)
+	ginEngine.GET("/my-pond", ctxutil.Authorization(), handler.GetListMyPond)
+	ginEngine.POST("/select-pond", ctxutil.Authorization(), handler.SelectPond)
 	ginEngine.GET("/list-pond", handler.GetAllPond)
 
 	ginEngine.GET("/list-pool", handler.GetListPool)
+	ginEngine.POST("/create-pool", ctxutil.Authorization(), handler.CreatePool)
+	ginEngine.POST("/update-pool", ctxutil.Authorization(), handler.UpdatePool)
+	ginEngine.POST("/archive-pool", ctxutil.Authorization(), handler.ArchivePool)
+
+	ginEngine.POST("/create-team", ctxutil.Authorization(), handler.CreateTeam)
+	ginEngine.POST("/invite-team-member", ctxutil.Authorization(), handler.InviteTeamMember)
+	ginEngine.POST("/accept-team-invitation", ctxutil.Authorization(), handler.AcceptTeamInvitation)
+	ginEngine.POST("/remove-team-member", ctxutil.Authorization(), handler.RemoveTeamMember)
+	ginEngine.GET("/team", ctxutil.Authorization(), handler.GetListTeam)
+	ginEngine.GET("/team/:id", ctxutil.Authorization(), handler.GetTeamByID)
+	ginEngine.GET("/team-invitation", ctxutil.Authorization(), handler.GetListTeamInvitation)
 
 	ginEngine.GET("/all-pond-submission", ctxutil.Authorization(), handler.GetAllPondSubmission)
 	ginEngine.POST("/update-pond-status", ctxutil.Authorization(), handler.UpdatePondStatus)
+	ginEngine.POST("/assign-reviewer-pond", ctxutil.Authorization(), handler.AssignReviewerPond)
+	ginEngine.POST("/review-berkas-pond", ctxutil.Authorization(), handler.ReviewBerkasPond)
+	ginEngine.GET("/pond/:id/review-notes", ctxutil.Authorization(), handler.GetListReviewNote)
 
 	ginEngine.POST("/upload-pond-photo", handler.SaveImagePond)
 	ginEngine.Use(static.Serve("/assets/image/pond", static.LocalFile(ro.conf.PondImageConfig.Path, false)))
@@ -6,9 +6,12 @@ import (
 
 	"github.com/e-fish/api/pkg/common/helper/ctxutil"
 	"github.com/e-fish/api/pkg/common/helper/logger"
+	"github.com/e-fish/api/pkg/common/infra/payment"
 	"github.com/e-fish/api/pkg/domain/budidaya"
+	"github.com/e-fish/api/pkg/domain/notification"
 	"github.com/e-fish/api/pkg/domain/pond"
 	"github.com/e-fish/api/pkg/domain/transaction"
+	"github.com/e-fish/api/pkg/domain/transaction/model"
 	"github.com/e-fish/api/pkg/domain/verification"
 	schedulerconfig "github.com/e-fish/api/scheduler/scheduler_config"
 	"github.com/e-fish/api/scheduler/scheduler_service/internal"
@@ -32,7 +35,13 @@ func NewService(conf schedulerconfig.SchedulerConfig) Service {
 		logger.Fatal("failed to create a new repo tenant, can't create scheduler service err: %v", err)
 	}
 
-	pondRepo, err := pond.NewRepo(conf.BudidayaConfig.DbConfig, verificationRepo)
+	// the scheduler doesn't change the status of the pond, the notification is only saved without the push message
+	notificationRepo, err := notification.NewRepo(conf.BudidayaConfig.DbConfig, nil)
+	if err != nil {
+		logger.Fatal("failed to create a new repo notification, can't create scheduler service err: %v", err)
+	}
+
+	pondRepo, err := pond.NewRepo(conf.BudidayaConfig.DbConfig, verificationRepo, notificationRepo)
 	if err != nil {
 		logger.Fatal("failed to create a new repo tenant, can't create scheduler service err: %v", err)
 	}
@@ -42,7 +51,12 @@ func NewService(conf schedulerconfig.SchedulerConfig) Service {
 		logger.Fatal("failed to create a new repo tenant, can't create product service err: %v", err)
 	}
 
-	transactionRepo, err := transaction.NewRepo(conf.TransactionConfig.DbConfig, budidayaRepo)
+	paymentGateway, err := payment.NewPaymentGateway(conf.TransactionConfig.PaymentConfig)
+	if err != nil {
+		logger.Fatal("failed to create a new payment gateway, can't create scheduler service err: %v", err)
+	}
+
+	transactionRepo, err := transaction.NewRepo(conf.TransactionConfig.DbConfig, budidayaRepo, pondRepo, paymentGateway)
 	if err != nil {
 		logger.Fatal("failed to create a new repo product, can't create product service err: %v", err)
 	}
@@ -61,7 +75,8 @@ func NewService(conf schedulerconfig.SchedulerConfig) Service {
 func (s *Service) Start() {
 	logger.Debug("Start scheduler")
 	go s.cancelOrder()
-
+	go s.releaseReservation()
+	go s.retryRefund()
 }
 
 func (s *Service) cancelOrder() {
@@ -78,6 +93,7 @@ func (s *Service) cancelOrder() {
 func (s *Service) UpdateOrderStatus(count int) {
 	ctx := context.Background()
 	ctx = ctxutil.NewRequest(ctx)
+	ctx = ctxutil.SetUserAppType(ctx, model.SYSTEM)
 
 	if count == 10 {
 		return
@@ -99,7 +115,12 @@ func (s *Service) UpdateOrderStatus(count int) {
 			continue
 		}
 		command := s.transactionRepo.NewCommand(ctx)
-		result, err := command.UpdateCancelOrder(ctx, order.ID)
+		result, err := command.UpdateOrderStatus(ctx, model.UpdateOrderStatusInput{
+			ID:     order.ID,
+			Status: model.CANCEL,
+			Reason: "the order is not completed 2 days after the booking date",
+			Source: model.SOURCE_SCHEDULER,
+		})
 		if err != nil {
 			if err := command.Rollback(ctx); err != nil {
 				logger.ErrorWithContext(ctx, "failed rollback transaction update transaction: %v", err)
@@ -114,3 +135,94 @@ func (s *Service) UpdateOrderStatus(count int) {
 	}
 	logger.DebugWithContext(ctx, "########Success update data")
 }
+
+// ReservationInterval is how often the expired reservation is released
+const ReservationInterval = 5 * time.Minute
+
+func (s *Service) releaseReservation() {
+	ticker := time.NewTicker(ReservationInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		s.CancelReservationExpired()
+	}
+}
+
+// CancelReservationExpired cancel the active order that is not confirmed until the reservation is expired,
+// so the reserved qty is returned to the budidaya stock
+func (s *Service) CancelReservationExpired() {
+	ctx := context.Background()
+	ctx = ctxutil.NewRequest(ctx)
+	ctx = ctxutil.SetUserAppType(ctx, model.SYSTEM)
+
+	query := s.transactionRepo.NewQuery()
+	orders, err := query.ReadAllOrderReservationExpired(ctx)
+	if err != nil {
+		logger.ErrorWithContext(ctx, "failed read order with expired reservation: %v", err)
+		return
+	}
+
+	for _, order := range orders {
+		command := s.transactionRepo.NewCommand(ctx)
+		result, err := command.UpdateOrderStatus(ctx, model.UpdateOrderStatusInput{
+			ID:     order.ID,
+			Status: model.CANCEL,
+			Reason: "the reservation is expired",
+			Source: model.SOURCE_SCHEDULER,
+		})
+		if err != nil {
+			if err := command.Rollback(ctx); err != nil {
+				logger.ErrorWithContext(ctx, "failed rollback transaction release reservation: %v", err)
+			}
+			continue
+		}
+		if err := command.Commit(ctx); err != nil {
+			logger.ErrorWithContext(ctx, "failed commit transaction release reservation: %v", err)
+			continue
+		}
+		logger.InfoWithContext(ctx, "Success release reservation order [%v]", result)
+	}
+}
+
+// RefundInterval is how often the refund that is not sent to the provider is retried
+const RefundInterval = 5 * time.Minute
+
+func (s *Service) retryRefund() {
+	ticker := time.NewTicker(RefundInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		s.RefundPaymentPending()
+	}
+}
+
+// RefundPaymentPending send the refund of the payment that is still refunding to the provider,
+// the refund is idempotent by the payment id so the refund that is already received by the provider is not sent twice
+func (s *Service) RefundPaymentPending() {
+	ctx := context.Background()
+	ctx = ctxutil.NewRequest(ctx)
+	ctx = ctxutil.SetUserAppType(ctx, model.SYSTEM)
+
+	query := s.transactionRepo.NewQuery()
+	payments, err := query.ReadAllPaymentByStatus(ctx, payment.REFUNDING)
+	if err != nil {
+		logger.ErrorWithContext(ctx, "failed read payment refunding: %v", err)
+		return
+	}
+
+	for _, v := range payments {
+		command := s.transactionRepo.NewCommand(ctx)
+		result, err := command.RefundPayment(ctx, v.ID)
+		if err != nil {
+			if err := command.Rollback(ctx); err != nil {
+				logger.ErrorWithContext(ctx, "failed rollback transaction refund payment: %v", err)
+			}
+			continue
+		}
+		if err := command.Commit(ctx); err != nil {
+			logger.ErrorWithContext(ctx, "failed commit transaction refund payment: %v", err)
+			continue
+		}
+		logger.InfoWithContext(ctx, "Success retry refund payment [%v]", result)
+	}
+}
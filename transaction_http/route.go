@@ -2,6 +2,8 @@ package transactionhttp
 
 import (
 	"github.com/e-fish/api/pkg/common/helper/ctxutil"
+	"github.com/e-fish/api/pkg/common/helper/logger"
+	"github.com/e-fish/api/pkg/common/helper/restsvr"
 	transactionconfig "github.com/e-fish/api/transaction_http/transaction_config"
 	transactionhandler "github.com/e-fish/api/transaction_http/transaction_handler"
 	transactionservice "github.com/e-fish/api/transaction_http/transaction_service"
@@ -23,10 +25,31 @@ func newRoute(ro route) {
 		Service: service,
 	}
 
-	ginEngine.POST("/create-order", ctxutil.Authorization(), handler.CreateOrder)
+	idempotencyStore, err := restsvr.NewIdempotencyStore(ro.conf.DbConfig)
+	if err != nil {
+		logger.Fatal("###failed create idempotency store err: %v", err)
+	}
+	idempotency := restsvr.Idempotency(idempotencyStore)
+
+	ginEngine.POST("/create-order", ctxutil.Authorization(), idempotency, handler.CreateOrder)
+	ginEngine.POST("/update-order", ctxutil.Authorization(), handler.UpdateOrder)
 	ginEngine.POST("/update-order-cancel", ctxutil.Authorization(), handler.UpdateOrderCancel)
 	ginEngine.POST("/update-order-success", ctxutil.Authorization(), handler.UpdateSuccessOrder)
+	ginEngine.POST("/update-order-status", ctxutil.Authorization(), handler.UpdateOrderStatus)
+	ginEngine.POST("/add-cart-item", ctxutil.Authorization(), handler.AddCartItem)
+	ginEngine.POST("/update-cart-item", ctxutil.Authorization(), handler.UpdateCartItem)
+	ginEngine.POST("/checkout", ctxutil.Authorization(), idempotency, handler.Checkout)
+	ginEngine.GET("/cart", ctxutil.Authorization(), handler.GetCart)
+	ginEngine.POST("/create-payment", ctxutil.Authorization(), handler.CreatePayment)
+	ginEngine.POST("/payment-callback", handler.PaymentCallback)
 	ginEngine.GET("/order", ctxutil.Authorization(), handler.GetOrder)
+	ginEngine.GET("/order/:id", ctxutil.Authorization(), handler.GetOrderDetail)
+	ginEngine.GET("/order/:id/history", ctxutil.Authorization(), handler.GetOrderStatusHistory)
+	ginEngine.GET("/order/:id/invoice", ctxutil.Authorization(), handler.GetOrderInvoice)
 	ginEngine.GET("/order-cancel", ctxutil.Authorization(), handler.GetOrderCancel)
 	ginEngine.GET("/order-success", ctxutil.Authorization(), handler.GetOrderSuccess)
+	ginEngine.GET("/sales-revenue", ctxutil.Authorization(), handler.GetSalesRevenue)
+	ginEngine.GET("/sales-fish-species", ctxutil.Authorization(), handler.GetSalesFishSpecies)
+	ginEngine.GET("/sales-top-buyer", ctxutil.Authorization(), handler.GetSalesTopBuyer)
+	ginEngine.GET("/sales-summary", ctxutil.Authorization(), handler.GetSalesSummary)
 }
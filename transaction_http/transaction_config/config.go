@@ -16,7 +16,8 @@ var (
 )
 
 type TransactionConfig struct {
-	DbConfig config.DbConfig
+	DbConfig      config.DbConfig
+	PaymentConfig config.PaymentConfig
 }
 
 // single tone
@@ -37,6 +38,10 @@ func getConfig() *TransactionConfig {
 			password := os.Getenv("DB_PASSWORD")
 			port := os.Getenv("DB_PORT")
 
+			paymentProvider := os.Getenv("PAYMENT_PROVIDER")
+			paymentSecretKey := os.Getenv("PAYMENT_SECRET_KEY")
+			paymentUrl := os.Getenv("PAYMENT_URL")
+
 			conf = &TransactionConfig{
 				DbConfig: config.DbConfig{
 					Driver:   driver,
@@ -46,6 +51,11 @@ func getConfig() *TransactionConfig {
 					Database: database,
 					Port:     port,
 				},
+				PaymentConfig: config.PaymentConfig{
+					Provider:   paymentProvider,
+					SecretKey:  paymentSecretKey,
+					PaymentUrl: paymentUrl,
+				},
 			}
 		})
 	}
@@ -77,6 +87,12 @@ func GetConfig() *TransactionConfig {
 	if dbConf.Port == "" {
 		errs.Add(config.ErrEmptyConfig.AttacthDetail(map[string]any{"field empty Port": "empty"}))
 	}
+	if conf.PaymentConfig.Provider == "" {
+		errs.Add(config.ErrEmptyConfig.AttacthDetail(map[string]any{"field empty Payment Provider": "empty"}))
+	}
+	if conf.PaymentConfig.SecretKey == "" {
+		errs.Add(config.ErrEmptyConfig.AttacthDetail(map[string]any{"field empty Payment SecretKey": "empty"}))
+	}
 
 	if err := errs.Return(); err != nil {
 		logger.Fatal("auth-config err: %v", err)
@@ -1,10 +1,16 @@
 package transactionhandler
 
 import (
+	"fmt"
+	"io"
+	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/e-fish/api/pkg/common/helper/restsvr"
 	"github.com/e-fish/api/pkg/common/infra/orm"
+	"github.com/e-fish/api/pkg/common/infra/payment"
+	errortransaction "github.com/e-fish/api/pkg/domain/transaction/error-transaction"
 	"github.com/e-fish/api/pkg/domain/transaction/model"
 	transactionconfig "github.com/e-fish/api/transaction_http/transaction_config"
 	transactionservice "github.com/e-fish/api/transaction_http/transaction_service"
@@ -130,6 +136,83 @@ func (h *Handler) GetOrderCancel(c *gin.Context) {
 	res.Add(result, err)
 }
 
+func (h *Handler) GetOrderDetail(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		res = new(restsvr.HttpResponse)
+	)
+	defer restsvr.ResponsJson(c, res)
+
+	uid, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.ReadOrderDetail(ctx, uid)
+	res.Add(result, err)
+}
+
+// GetOrderInvoice download the invoice of the order as pdf
+func (h *Handler) GetOrderInvoice(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		res = new(restsvr.HttpResponse)
+	)
+
+	uid, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		res.Add(nil, err)
+		restsvr.ResponsJson(c, res)
+		return
+	}
+
+	result, err := h.Service.GenerateOrderInvoice(ctx, uid)
+	if err != nil {
+		res.Add(nil, err)
+		restsvr.ResponsJson(c, res)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", result.Name))
+	c.Data(http.StatusOK, "application/pdf", result.Data)
+}
+
+func (h *Handler) GetOrderStatusHistory(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		res = new(restsvr.HttpResponse)
+	)
+	defer restsvr.ResponsJson(c, res)
+
+	uid, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.ReadOrderStatusHistory(ctx, uid)
+	res.Add(result, err)
+}
+
+func (h *Handler) UpdateOrder(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req = model.UpdateOrderInput{}
+		res = new(restsvr.HttpResponse)
+	)
+	defer restsvr.ResponsJson(c, res)
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.UpdateOrder(ctx, req)
+	res.Add(result, err)
+}
+
 func (h *Handler) UpdateOrderCancel(c *gin.Context) {
 	var (
 		ctx = c.Request.Context()
@@ -165,3 +248,223 @@ func (h *Handler) UpdateSuccessOrder(c *gin.Context) {
 	result, err := h.Service.UpdateOrderSuccess(ctx, req.ID)
 	res.Add(result, err)
 }
+
+func (h *Handler) UpdateOrderStatus(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req = model.UpdateOrderStatusInput{}
+		res = new(restsvr.HttpResponse)
+	)
+	defer restsvr.ResponsJson(c, res)
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.UpdateOrderStatus(ctx, req)
+	res.Add(result, err)
+}
+
+func (h *Handler) CreatePayment(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req = model.CreatePaymentInput{}
+		res = new(restsvr.HttpResponse)
+	)
+	defer restsvr.ResponsJson(c, res)
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.CreatePayment(ctx, req)
+	res.Add(result, err)
+}
+
+// PaymentCallback is the webhook called by the payment provider,
+// the request is verified with the signature instead of the user token
+func (h *Handler) PaymentCallback(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		res = new(restsvr.HttpResponse)
+	)
+	defer restsvr.ResponsJson(c, res)
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.HandlePaymentCallback(ctx, model.PaymentCallbackInput{
+		Signature: c.GetHeader(payment.SIGNATURE_HEADER),
+		Body:      body,
+	})
+	res.Add(result, err)
+}
+
+func (h *Handler) AddCartItem(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req = model.AddCartItemInput{}
+		res = new(restsvr.HttpResponse)
+	)
+	defer restsvr.ResponsJson(c, res)
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.AddCartItem(ctx, req)
+	res.Add(result, err)
+}
+
+func (h *Handler) UpdateCartItem(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req = model.UpdateCartItemInput{}
+		res = new(restsvr.HttpResponse)
+	)
+	defer restsvr.ResponsJson(c, res)
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.UpdateCartItem(ctx, req)
+	res.Add(result, err)
+}
+
+func (h *Handler) Checkout(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req = model.CheckoutInput{}
+		res = new(restsvr.HttpResponse)
+	)
+	defer restsvr.ResponsJson(c, res)
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.Checkout(ctx, req)
+	res.Add(result, err)
+}
+
+func (h *Handler) GetCart(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		res = new(restsvr.HttpResponse)
+	)
+	defer restsvr.ResponsJson(c, res)
+
+	result, err := h.Service.ReadCart(ctx)
+	res.Add(result, err)
+}
+
+// salesInput read the date range and granularity of the sales analytics from the query param,
+// the date use the format yyyy-mm-dd
+func salesInput(c *gin.Context) (model.SalesInput, error) {
+	var (
+		input = model.SalesInput{
+			Granularity: c.Query("granularity"),
+		}
+		err error
+	)
+
+	input.Limit, _ = strconv.Atoi(c.Query("limit"))
+	input.PondID, _ = uuid.Parse(c.Query("pondID"))
+
+	if startDate := c.Query("startDate"); startDate != "" {
+		input.StartDate, err = time.Parse(model.DATE_FORMAT, startDate)
+		if err != nil {
+			return input, errortransaction.ErrValidateSalesInput.AttacthDetail(map[string]any{"startDate": startDate})
+		}
+	}
+	if endDate := c.Query("endDate"); endDate != "" {
+		input.EndDate, err = time.Parse(model.DATE_FORMAT, endDate)
+		if err != nil {
+			return input, errortransaction.ErrValidateSalesInput.AttacthDetail(map[string]any{"endDate": endDate})
+		}
+	}
+
+	return input, nil
+}
+
+func (h *Handler) GetSalesRevenue(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		res = new(restsvr.HttpResponse)
+	)
+	defer restsvr.ResponsJson(c, res)
+
+	input, err := salesInput(c)
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.ReadSalesRevenue(ctx, input)
+	res.Add(result, err)
+}
+
+func (h *Handler) GetSalesFishSpecies(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		res = new(restsvr.HttpResponse)
+	)
+	defer restsvr.ResponsJson(c, res)
+
+	input, err := salesInput(c)
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.ReadSalesFishSpecies(ctx, input)
+	res.Add(result, err)
+}
+
+func (h *Handler) GetSalesTopBuyer(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		res = new(restsvr.HttpResponse)
+	)
+	defer restsvr.ResponsJson(c, res)
+
+	input, err := salesInput(c)
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.ReadSalesTopBuyer(ctx, input)
+	res.Add(result, err)
+}
+
+func (h *Handler) GetSalesSummary(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		res = new(restsvr.HttpResponse)
+	)
+	defer restsvr.ResponsJson(c, res)
+
+	input, err := salesInput(c)
+	if err != nil {
+		res.Add(nil, err)
+		return
+	}
+
+	result, err := h.Service.ReadSalesSummary(ctx, input)
+	res.Add(result, err)
+}
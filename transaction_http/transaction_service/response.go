@@ -0,0 +1,6 @@
+package transactionservice
+
+type InvoiceResponse struct {
+	Name string
+	Data []byte
+}
@@ -2,9 +2,14 @@ package transactionservice
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/e-fish/api/pkg/common/helper/logger"
+	"github.com/e-fish/api/pkg/common/helper/savefile"
+	"github.com/e-fish/api/pkg/common/infra/payment"
 	"github.com/e-fish/api/pkg/domain/budidaya"
+	"github.com/e-fish/api/pkg/domain/notification"
 	"github.com/e-fish/api/pkg/domain/pond"
 	"github.com/e-fish/api/pkg/domain/transaction"
 	"github.com/e-fish/api/pkg/domain/transaction/model"
@@ -20,7 +25,13 @@ func NewService(conf transactionconfig.TransactionConfig) Service {
 		logger.Fatal("###failed create transaction service [causes: %v, err: %v]", "verification.NewRepo", err)
 	}
 
-	pondRepo, err := pond.NewRepo(conf.DbConfig, verificationRepo)
+	// the transaction doesn't change the status of the pond, the notification is only saved without the push message
+	notificationRepo, err := notification.NewRepo(conf.DbConfig, nil)
+	if err != nil {
+		logger.Fatal("###failed create transaction service [causes: %v, err: %v]", "notification.NewRepo", err)
+	}
+
+	pondRepo, err := pond.NewRepo(conf.DbConfig, verificationRepo, notificationRepo)
 	if err != nil {
 		logger.Fatal("###failed create transaction service [causes: %v, err: %v]", "pond.NewRepo", err)
 	}
@@ -30,7 +41,12 @@ func NewService(conf transactionconfig.TransactionConfig) Service {
 		logger.Fatal("###failed create transaction service [causes: %v, err: %v]", "budidaya.NewRepo", err)
 	}
 
-	repo, err := transaction.NewRepo(conf.DbConfig, budidayaRepo)
+	paymentGateway, err := payment.NewPaymentGateway(conf.PaymentConfig)
+	if err != nil {
+		logger.Fatal("###failed create transaction service [causes: %v, err: %v]", "payment.NewPaymentGateway", err)
+	}
+
+	repo, err := transaction.NewRepo(conf.DbConfig, budidayaRepo, pondRepo, paymentGateway)
 	if err != nil {
 		logger.Fatal("###failed create transaction service [causes: %v, err: %v]", "transaction.NewRepo", err)
 	}
@@ -83,6 +99,59 @@ func (s *Service) ReadOrderSuccess(ctx context.Context, input model.ReadInput) (
 	return result, err
 }
 
+func (s *Service) ReadOrderDetail(ctx context.Context, id uuid.UUID) (*model.OrderOutput, error) {
+	query := s.repo.NewQuery()
+	result, err := query.ReadOrderDetail(ctx, id)
+	return result, err
+}
+
+// GenerateOrderInvoice render the invoice of the order, the file is not saved
+// so the invoice is only downloaded by the user that can read the order
+func (s *Service) GenerateOrderInvoice(ctx context.Context, id uuid.UUID) (*InvoiceResponse, error) {
+	query := s.repo.NewQuery()
+	invoice, err := query.ReadOrderInvoice(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := transaction.GenerateInvoicePDF(*invoice)
+	if err != nil {
+		return nil, err
+	}
+
+	result := InvoiceResponse{
+		Name: fmt.Sprintf("%v-%v.%v", strings.ToLower(invoice.Title), id, savefile.PDF),
+		Data: data,
+	}
+
+	return &result, nil
+}
+
+func (s *Service) ReadOrderStatusHistory(ctx context.Context, orderID uuid.UUID) ([]*model.OrderStatusHistoryOutput, error) {
+	query := s.repo.NewQuery()
+	result, err := query.ReadOrderStatusHistory(ctx, orderID)
+	return result, err
+}
+
+func (s *Service) UpdateOrder(ctx context.Context, input model.UpdateOrderInput) (*uuid.UUID, error) {
+	command := s.repo.NewCommand(ctx)
+	result, err := command.UpdateOrder(ctx, input)
+	if err != nil {
+		if err := command.Rollback(ctx); err != nil {
+			logger.ErrorWithContext(ctx, "failed rollback transaction err: %v", err)
+		}
+		logger.ErrorWithContext(ctx, "failed update order err: %v", err)
+		return nil, err
+	}
+
+	if err := command.Commit(ctx); err != nil {
+		logger.ErrorWithContext(ctx, "failed commit transaction err: %v", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
 func (s *Service) UpdateOrderCancel(ctx context.Context, input uuid.UUID) (*uuid.UUID, error) {
 	command := s.repo.NewCommand(ctx)
 	result, err := command.UpdateCancelOrder(ctx, input)
@@ -120,3 +189,143 @@ func (s *Service) UpdateOrderSuccess(ctx context.Context, input uuid.UUID) (*uui
 
 	return result, nil
 }
+
+func (s *Service) UpdateOrderStatus(ctx context.Context, input model.UpdateOrderStatusInput) (*uuid.UUID, error) {
+	command := s.repo.NewCommand(ctx)
+	result, err := command.UpdateOrderStatus(ctx, input)
+	if err != nil {
+		if err := command.Rollback(ctx); err != nil {
+			logger.ErrorWithContext(ctx, "failed rollback transaction err: %v", err)
+		}
+		logger.ErrorWithContext(ctx, "failed update order status err: %v", err)
+		return nil, err
+	}
+
+	if err := command.Commit(ctx); err != nil {
+		logger.ErrorWithContext(ctx, "failed commit transaction err: %v", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *Service) CreatePayment(ctx context.Context, input model.CreatePaymentInput) (*model.PaymentOutput, error) {
+	command := s.repo.NewCommand(ctx)
+	result, err := command.CreatePayment(ctx, input)
+	if err != nil {
+		if err := command.Rollback(ctx); err != nil {
+			logger.ErrorWithContext(ctx, "failed rollback transaction err: %v", err)
+		}
+		logger.ErrorWithContext(ctx, "failed create payment err: %v", err)
+		return nil, err
+	}
+
+	if err := command.Commit(ctx); err != nil {
+		logger.ErrorWithContext(ctx, "failed commit transaction err: %v", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *Service) HandlePaymentCallback(ctx context.Context, input model.PaymentCallbackInput) (*uuid.UUID, error) {
+	command := s.repo.NewCommand(ctx)
+	result, err := command.HandlePaymentCallback(ctx, input)
+	if err != nil {
+		if err := command.Rollback(ctx); err != nil {
+			logger.ErrorWithContext(ctx, "failed rollback transaction err: %v", err)
+		}
+		logger.ErrorWithContext(ctx, "failed handle payment callback err: %v", err)
+		return nil, err
+	}
+
+	if err := command.Commit(ctx); err != nil {
+		logger.ErrorWithContext(ctx, "failed commit transaction err: %v", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *Service) AddCartItem(ctx context.Context, input model.AddCartItemInput) (*uuid.UUID, error) {
+	command := s.repo.NewCommand(ctx)
+	result, err := command.AddCartItem(ctx, input)
+	if err != nil {
+		if err := command.Rollback(ctx); err != nil {
+			logger.ErrorWithContext(ctx, "failed rollback transaction err: %v", err)
+		}
+		logger.ErrorWithContext(ctx, "failed add cart item err: %v", err)
+		return nil, err
+	}
+
+	if err := command.Commit(ctx); err != nil {
+		logger.ErrorWithContext(ctx, "failed commit transaction err: %v", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *Service) UpdateCartItem(ctx context.Context, input model.UpdateCartItemInput) (*uuid.UUID, error) {
+	command := s.repo.NewCommand(ctx)
+	result, err := command.UpdateCartItem(ctx, input)
+	if err != nil {
+		if err := command.Rollback(ctx); err != nil {
+			logger.ErrorWithContext(ctx, "failed rollback transaction err: %v", err)
+		}
+		logger.ErrorWithContext(ctx, "failed update cart item err: %v", err)
+		return nil, err
+	}
+
+	if err := command.Commit(ctx); err != nil {
+		logger.ErrorWithContext(ctx, "failed commit transaction err: %v", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *Service) Checkout(ctx context.Context, input model.CheckoutInput) (*uuid.UUID, error) {
+	command := s.repo.NewCommand(ctx)
+	result, err := command.Checkout(ctx, input)
+	if err != nil {
+		if err := command.Rollback(ctx); err != nil {
+			logger.ErrorWithContext(ctx, "failed rollback transaction err: %v", err)
+		}
+		logger.ErrorWithContext(ctx, "failed checkout err: %v", err)
+		return nil, err
+	}
+
+	if err := command.Commit(ctx); err != nil {
+		logger.ErrorWithContext(ctx, "failed commit transaction err: %v", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *Service) ReadCart(ctx context.Context) ([]*model.CartOutput, error) {
+	query := s.repo.NewQuery()
+	result, err := query.ReadCart(ctx)
+	return result, err
+}
+
+func (s *Service) ReadSalesRevenue(ctx context.Context, input model.SalesInput) ([]*model.SalesRevenueOutput, error) {
+	query := s.repo.NewQuery()
+	return query.ReadSalesRevenue(ctx, input)
+}
+
+func (s *Service) ReadSalesFishSpecies(ctx context.Context, input model.SalesInput) ([]*model.SalesFishSpeciesOutput, error) {
+	query := s.repo.NewQuery()
+	return query.ReadSalesFishSpecies(ctx, input)
+}
+
+func (s *Service) ReadSalesTopBuyer(ctx context.Context, input model.SalesInput) ([]*model.SalesTopBuyerOutput, error) {
+	query := s.repo.NewQuery()
+	return query.ReadSalesTopBuyer(ctx, input)
+}
+
+func (s *Service) ReadSalesSummary(ctx context.Context, input model.SalesInput) (*model.SalesSummaryOutput, error) {
+	query := s.repo.NewQuery()
+	return query.ReadSalesSummary(ctx, input)
+}